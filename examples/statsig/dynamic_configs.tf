@@ -0,0 +1,30 @@
+resource "statsig_dynamic_config" "test" {
+  name        = "test_tf_config"
+  description = "test dynamic config created in terraform"
+  tags        = [statsig_tag.test.name]
+
+  default_value = jsonencode({
+    max_items = 10
+    theme     = "light"
+  })
+
+  rule {
+    name            = "beta testers"
+    pass_percentage = 100
+
+    condition {
+      type         = "passes_gate"
+      operator     = "any"
+      target_value = [statsig_gate.test.id]
+    }
+
+    return_value = jsonencode({
+      max_items = 50
+      theme     = "dark"
+    })
+  }
+}
+
+output "test_dynamic_config" {
+  value = statsig_dynamic_config.test
+}
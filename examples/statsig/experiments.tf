@@ -0,0 +1,35 @@
+resource "statsig_experiment" "test" {
+  name               = "test_tf_experiment"
+  description        = "test experiment created in terraform"
+  hypothesis         = "A larger item limit increases checkouts"
+  allocation_percent = 50
+  targeting_gate_id  = statsig_gate.test.id
+  layer_id           = statsig_layer.test.id
+  tags               = [statsig_tag.test.name]
+
+  primary_metrics = [
+    {
+      name = "checkout"
+      type = "event_count"
+    },
+  ]
+
+  group {
+    name             = "control"
+    size             = 50
+    parameter_values = jsonencode({ max_items = 10, theme = "light" })
+  }
+
+  group {
+    name             = "test"
+    size             = 50
+    parameter_values = jsonencode({ max_items = 50, theme = "light" })
+  }
+
+  # Move the status forward to start, conclude or abandon the experiment.
+  status = "setup"
+}
+
+output "test_experiment" {
+  value = statsig_experiment.test
+}
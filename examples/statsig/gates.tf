@@ -0,0 +1,33 @@
+resource "statsig_gate" "test" {
+  name        = "test_tf_gate"
+  description = "test gate created in terraform"
+  is_enabled  = true
+  id_type     = "userID"
+  tags        = [statsig_tag.test.name]
+  target_apps = [statsig_target_app.test.id]
+
+  rule {
+    name            = "employees"
+    pass_percentage = 100
+    environments    = ["development", "staging"]
+
+    condition {
+      type         = "email"
+      operator     = "str_contains_any"
+      target_value = ["@example.com"]
+    }
+  }
+
+  rule {
+    name            = "rollout"
+    pass_percentage = 10
+
+    condition {
+      type = "public"
+    }
+  }
+}
+
+output "test_gate" {
+  value = statsig_gate.test
+}
@@ -0,0 +1,20 @@
+resource "statsig_holdout" "growth" {
+  name            = "test_tf_growth_holdout"
+  description     = "growth holdout created in terraform"
+  pass_percentage = 5
+
+  gates       = [statsig_gate.test.id]
+  experiments = [statsig_experiment.test.id]
+  layers      = [statsig_layer.test.id]
+}
+
+resource "statsig_holdout" "global" {
+  name            = "test_tf_global_holdout"
+  description     = "global holdout created in terraform"
+  pass_percentage = 1
+  is_global       = true
+}
+
+output "test_holdout" {
+  value = statsig_holdout.growth
+}
@@ -0,0 +1,20 @@
+resource "statsig_layer" "test" {
+  name        = "test_tf_layer"
+  description = "test layer created in terraform"
+
+  parameter {
+    name          = "max_items"
+    type          = "number"
+    default_value = jsonencode(10)
+  }
+
+  parameter {
+    name          = "theme"
+    type          = "string"
+    default_value = jsonencode("light")
+  }
+}
+
+output "test_layer" {
+  value = statsig_layer.test
+}
@@ -0,0 +1,45 @@
+resource "statsig_metric" "purchases" {
+  name        = "test_tf_purchases"
+  description = "purchase count metric created in terraform"
+  type        = "event_count"
+  event_name  = "purchase"
+}
+
+resource "statsig_metric" "revenue" {
+  name        = "test_tf_revenue"
+  description = "revenue metric created in terraform"
+  type        = "sum"
+  event_name  = "purchase"
+  value_key   = "price"
+}
+
+resource "statsig_metric" "conversion" {
+  name              = "test_tf_conversion"
+  description       = "conversion rate metric created in terraform"
+  type              = "ratio"
+  numerator_event   = "purchase"
+  denominator_event = "view_item"
+}
+
+resource "statsig_metric" "checkout" {
+  name          = "test_tf_checkout"
+  description   = "checkout funnel metric created in terraform"
+  type          = "funnel"
+  funnel_events = ["view_item", "add_to_cart", "purchase"]
+}
+
+resource "statsig_metric_source" "orders" {
+  name             = "test_tf_orders"
+  description      = "orders metric source created in terraform"
+  sql              = "SELECT user_id, created_at, total FROM analytics.orders"
+  timestamp_column = "created_at"
+
+  id_type_mapping {
+    id_type = "userID"
+    column  = "user_id"
+  }
+}
+
+output "test_metric" {
+  value = statsig_metric.conversion
+}
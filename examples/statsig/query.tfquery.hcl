@@ -0,0 +1,16 @@
+# Lists the existing objects of the Statsig project with `terraform query`. Run
+# `terraform query -generate-config-out=generated.tf` to write their configuration and import blocks.
+list "statsig_gate" "all" {
+  provider         = statsig
+  include_resource = true
+}
+
+list "statsig_tag" "all" {
+  provider         = statsig
+  include_resource = true
+}
+
+list "statsig_target_app" "all" {
+  provider         = statsig
+  include_resource = true
+}
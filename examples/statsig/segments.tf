@@ -0,0 +1,33 @@
+resource "statsig_segment" "internal_users" {
+  name        = "test_tf_internal_users"
+  description = "internal users segment created in terraform"
+  type        = "rule_based"
+
+  rule {
+    name            = "Employees"
+    pass_percentage = 100
+
+    condition {
+      type         = "email"
+      operator     = "str_contains_any"
+      target_value = ["@example.com"]
+    }
+  }
+}
+
+resource "statsig_segment" "beta_testers" {
+  name        = "test_tf_beta_testers"
+  description = "beta testers segment created in terraform"
+  type        = "id_list"
+  ids         = ["user-1", "user-2", "user-3"]
+
+  # Large ID lists are sent in several requests, which may take longer than the default timeouts.
+  timeouts {
+    create = "15m"
+    update = "15m"
+  }
+}
+
+output "test_segment" {
+  value = statsig_segment.internal_users
+}
@@ -1,6 +1,19 @@
 resource "statsig_target_app" "test" {
   name        = "test_tf"
   description = "test target app created in terraform"
+
+  gates = [statsig_gate.test.id]
+}
+
+resource "statsig_target_app" "assigned" {
+  name        = "test_tf_assigned"
+  description = "test target app with members managed by assignments"
+}
+
+resource "statsig_target_app_assignment" "dynamic_config" {
+  target_app_id = statsig_target_app.assigned.id
+  entity_type   = "dynamic_config"
+  entity_id     = statsig_dynamic_config.test.id
 }
 
 output "test_target_app" {
@@ -0,0 +1,274 @@
+// Package export writes the Terraform configuration of the objects of an existing Statsig project, along with the
+// import blocks that bring them under the management of Terraform.
+//
+// The objects are read with the list resources of the provider, so the exported configuration matches the schema
+// and the state of the resources exactly.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/provider"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// providerFile holds the provider requirements and configuration of the exported project.
+	providerFile = "provider.tf"
+	// importsFile holds the import blocks. It can be deleted once the objects are imported.
+	importsFile = "imports.tf"
+)
+
+// Result summarizes an export.
+type Result struct {
+	// Files are the names of the files written to the output directory.
+	Files []string
+	// Resources is the number of objects exported.
+	Resources int
+}
+
+// exportedResource is an object of the project, as written to the configuration.
+type exportedResource struct {
+	typeName string
+	label    string
+	id       string
+	block    *hclwrite.Block
+}
+
+// Export reads every object of the Statsig project with the client, and writes their configuration to dir.
+//
+// Each resource type is written to its own file, such as gates.tf, and the import blocks of every object are
+// written to imports.tf. The directory is created when needed, but existing files are never overwritten.
+func Export(ctx context.Context, client statsig.StatsigAPI, dir string) (*Result, error) {
+	p, ok := provider.New("export")().(fwprovider.ProviderWithListResources)
+	if !ok {
+		return nil, errors.New("the provider does not implement list resources")
+	}
+
+	resources := map[string]resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		resources[typeName(ctx, r.Metadata)] = r
+	}
+
+	files := map[string][]byte{}
+	var order []string
+	var exported []exportedResource
+
+	for _, newListResource := range p.ListResources(ctx) {
+		listResource := newListResource()
+		name := typeName(ctx, listResource.Metadata)
+
+		objects, err := listObjects(ctx, client, listResource, resources[name])
+		if err != nil {
+			return nil, fmt.Errorf("unable to export the %s resources: %w", name, err)
+		}
+		if len(objects) == 0 {
+			continue
+		}
+
+		f := hclwrite.NewEmptyFile()
+		for i, object := range objects {
+			if i > 0 {
+				f.Body().AppendNewline()
+			}
+			f.Body().AppendBlock(object.block)
+		}
+
+		fileName := strings.TrimPrefix(name, "statsig_") + "s.tf"
+		files[fileName] = hclwrite.Format(f.Bytes())
+		order = append(order, fileName)
+		exported = append(exported, objects...)
+	}
+
+	files[providerFile] = providerConfig()
+	files[importsFile] = importBlocks(exported)
+	order = append([]string{providerFile}, append(order, importsFile)...)
+
+	if err := writeFiles(dir, order, files); err != nil {
+		return nil, err
+	}
+
+	return &Result{Files: order, Resources: len(exported)}, nil
+}
+
+// listObjects lists every object of a resource type with its list resource, and builds their configuration from
+// the schema of the resource.
+func listObjects(ctx context.Context, client statsig.StatsigAPI, listResource list.ListResource, r resource.Resource) ([]exportedResource, error) {
+	if r == nil {
+		return nil, errors.New("no resource matches the list resource")
+	}
+
+	if listResource, ok := listResource.(list.ListResourceWithConfigure); ok {
+		resp := &resource.ConfigureResponse{}
+		listResource.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, resp)
+		if err := diagnosticsError(resp.Diagnostics); err != nil {
+			return nil, err
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	if r, ok := r.(resource.ResourceWithIdentity); ok {
+		r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+	}
+
+	stream := &list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}, stream)
+	if stream.Results == nil {
+		return nil, nil
+	}
+
+	name := typeName(ctx, r.Metadata)
+	labels := map[string]bool{}
+	var objects []exportedResource
+
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			return nil, err
+		}
+
+		var id types.String
+		if err := diagnosticsError(result.Identity.GetAttribute(ctx, path.Root("id"), &id)); err != nil {
+			return nil, err
+		}
+
+		label := uniqueLabel(labels, result.DisplayName, id.ValueString())
+		block := hclwrite.NewBlock("resource", []string{name, label})
+		if err := writeBody(block.Body(), schemaResp.Schema.Attributes, schemaResp.Schema.Blocks, result.Resource.Raw); err != nil {
+			return nil, fmt.Errorf("unable to write the configuration of %s: %w", id.ValueString(), err)
+		}
+
+		objects = append(objects, exportedResource{typeName: name, label: label, id: id.ValueString(), block: block})
+	}
+
+	return objects, nil
+}
+
+// providerConfig returns the provider requirements and configuration of the exported project. The Console API key
+// is a variable, so it is never written to the configuration.
+func providerConfig() []byte {
+	f := hclwrite.NewEmptyFile()
+
+	requiredProviders := f.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	requiredProviders.SetAttributeValue("statsig", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("tbd/statsig"),
+	}))
+	f.Body().AppendNewline()
+
+	variable := f.Body().AppendNewBlock("variable", []string{"console_api_key"}).Body()
+	variable.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	variable.SetAttributeValue("description", cty.StringVal("The Statsig Console API Key"))
+	variable.SetAttributeValue("sensitive", cty.True)
+	f.Body().AppendNewline()
+
+	f.Body().AppendNewBlock("provider", []string{"statsig"}).Body().SetAttributeTraversal("console_api_key", hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: "console_api_key"},
+	})
+
+	return hclwrite.Format(f.Bytes())
+}
+
+// importBlocks returns the import blocks of the exported objects, in the order they were exported.
+func importBlocks(exported []exportedResource) []byte {
+	f := hclwrite.NewEmptyFile()
+
+	for i, object := range exported {
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+		block := f.Body().AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: object.typeName},
+			hcl.TraverseAttr{Name: object.label},
+		})
+		block.SetAttributeValue("id", cty.StringVal(object.id))
+	}
+
+	return hclwrite.Format(f.Bytes())
+}
+
+// writeFiles writes the files to dir. No file is written when one of them already exists, so an export never
+// overwrites configuration.
+func writeFiles(dir string, order []string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for _, name := range order {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return fmt.Errorf("%s already exists, export to an empty directory instead", filepath.Join(dir, name))
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	for _, name := range order {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// typeName returns the name of the resource type described by metadata, such as statsig_gate.
+func typeName(ctx context.Context, metadata func(context.Context, resource.MetadataRequest, *resource.MetadataResponse)) string {
+	resp := &resource.MetadataResponse{}
+	metadata(ctx, resource.MetadataRequest{ProviderTypeName: "statsig"}, resp)
+	return resp.TypeName
+}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueLabel returns the label of the resource block of an object, derived from its name and unique among the
+// labels of its resource type. The ID is used for objects without a name.
+func uniqueLabel(labels map[string]bool, name string, id string) string {
+	if name == "" {
+		name = id
+	}
+
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+
+	unique := label
+	for i := 2; labels[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[unique] = true
+
+	return unique
+}
+
+// diagnosticsError returns an error joining the error diagnostics, or nil when there are none.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	api := statsigfake.New()
+
+	if _, err := api.CreateTag(ctx, statsig.TagAPIRequest{Name: "Core Tag", Description: "The core tag", IsCore: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := api.CreateGate(ctx, statsig.GateAPIRequest{
+		Name:        "checkout",
+		Description: "The new checkout",
+		IDType:      "userID",
+		Rules: []statsig.RuleAPIRequest{{
+			ID:             "rule-1",
+			Name:           "employees",
+			PassPercentage: 100,
+			Conditions: []statsig.ConditionAPIRequest{
+				{Type: "email", Operator: "str_contains_any", TargetValue: statsig.TargetValue{"@example.com"}},
+			},
+		}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	experimentIDType := "userID"
+	if _, err := api.CreateExperiment(ctx, statsig.ExperimentAPIRequest{
+		Name:           "pricing",
+		IDType:         &experimentIDType,
+		Allocation:     50,
+		PrimaryMetrics: []statsig.ExperimentMetricAPIRequest{{Name: "purchases", Type: "event_count"}},
+		Groups: []statsig.ExperimentGroupAPIRequest{
+			{Name: "control", Size: 50, ParameterValues: json.RawMessage(`{"price":10}`)},
+			{Name: "test", Size: 50, ParameterValues: json.RawMessage(`{"price":12}`)},
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := api.CreateTargetApp(ctx, statsig.TargetAppAPIRequest{ID: "3x7Fa", Name: "web", Gates: &[]string{"checkout"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "export")
+	result, err := Export(ctx, api, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedFiles := []string{"provider.tf", "tags.tf", "target_apps.tf", "gates.tf", "experiments.tf", "imports.tf"}
+	if !slices.Equal(result.Files, expectedFiles) || result.Resources != 4 {
+		t.Fatalf("expected %v with 4 resources, got %+v", expectedFiles, result)
+	}
+
+	files := map[string]string{}
+	for _, name := range result.Files {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, diags := hclwrite.ParseConfig(data, name, hcl.InitialPos); diags.HasErrors() {
+			t.Fatalf("expected %s to be valid HCL, got: %s", name, diags)
+		}
+		if formatted := hclwrite.Format(data); string(formatted) != string(data) {
+			t.Errorf("expected %s to be formatted, got:\n%s", name, data)
+		}
+		files[name] = string(data)
+	}
+
+	expectedContents := map[string][]string{
+		"provider.tf": {`source = "tbd/statsig"`, "console_api_key = var.console_api_key"},
+		"tags.tf":     {`resource "statsig_tag" "core_tag" {`, `name        = "Core Tag"`, "is_core     = true"},
+		"gates.tf":    {`resource "statsig_gate" "checkout" {`, "  rule {", "    condition {", `target_value = ["@example.com"]`},
+		"experiments.tf": {
+			`resource "statsig_experiment" "pricing" {`,
+			"allocation_percent = 50",
+			`name = "purchases"`,
+		},
+		"target_apps.tf": {`resource "statsig_target_app" "web" {`, `= ["checkout"]`},
+		"imports.tf": {
+			"to = statsig_tag.core_tag\n  id = \"tag1\"",
+			"to = statsig_target_app.web\n  id = \"3x7Fa\"",
+			"to = statsig_gate.checkout\n  id = \"checkout\"",
+		},
+	}
+	for name, contents := range expectedContents {
+		for _, content := range contents {
+			if !strings.Contains(files[name], content) {
+				t.Errorf("expected %s to contain %q, got:\n%s", name, content, files[name])
+			}
+		}
+	}
+
+	// Computed attributes are left out of the configuration.
+	if strings.Contains(files["gates.tf"], "rule-1") {
+		t.Errorf("expected the computed ID of the rule to be left out, got:\n%s", files["gates.tf"])
+	}
+
+	if _, err := Export(ctx, api, dir); err == nil {
+		t.Error("expected an error when exporting to a directory with existing files")
+	}
+}
+
+func TestUniqueLabel(t *testing.T) {
+	labels := map[string]bool{}
+
+	testCases := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{name: "checkout", expected: "checkout"},
+		{name: "Checkout", expected: "checkout_2"},
+		{name: "New Checkout (v2)", expected: "new_checkout_v2"},
+		{name: "2024 pricing", expected: "_2024_pricing"},
+		{id: "3x7Fa", expected: "_3x7fa"},
+		{name: "!!!", expected: "_"},
+	}
+
+	for _, testCase := range testCases {
+		if label := uniqueLabel(labels, testCase.name, testCase.id); label != testCase.expected {
+			t.Errorf("expected the label of %q to be %q, got %q", testCase.name+testCase.id, testCase.expected, label)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package export
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// writeBody writes the attributes and nested blocks of an object to body, following the schema of the resource.
+//
+// Computed attributes that cannot be configured and null values are left out, so the body is the configuration
+// that produces the object. Required attributes are written first, then the optional ones, in alphabetical order.
+func writeBody(body *hclwrite.Body, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value) error {
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return err
+	}
+
+	for _, name := range attributeNames(attributes) {
+		field := fields[name]
+		if !configurable(attributes[name]) || field.IsNull() {
+			continue
+		}
+
+		v, err := attributeValue(attributes[name], field)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		body.SetAttributeValue(name, v)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(blocks)) {
+		field := fields[name]
+		if field.IsNull() {
+			continue
+		}
+
+		switch block := blocks[name].(type) {
+		case schema.ListNestedBlock:
+			if err := writeNestedBlocks(body, name, block.NestedObject, field); err != nil {
+				return err
+			}
+		case schema.SetNestedBlock:
+			if err := writeNestedBlocks(body, name, block.NestedObject, field); err != nil {
+				return err
+			}
+		case schema.SingleNestedBlock:
+			if err := writeBody(body.AppendNewBlock(name, nil).Body(), block.Attributes, block.Blocks, field); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		default:
+			return fmt.Errorf("%s: unsupported block type %T", name, block)
+		}
+	}
+
+	return nil
+}
+
+// writeNestedBlocks writes a block to body for each element of a list or set of nested blocks.
+func writeNestedBlocks(body *hclwrite.Body, name string, object schema.NestedBlockObject, value tftypes.Value) error {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		if err := writeBody(body.AppendNewBlock(name, nil).Body(), object.Attributes, object.Blocks, element); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// attributeValue converts the value of an attribute. The computed attributes of nested attributes are left out,
+// as they are for the attributes of the resource.
+func attributeValue(attribute schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	switch attribute := attribute.(type) {
+	case schema.ListNestedAttribute:
+		return nestedValues(attribute.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return nestedValues(attribute.NestedObject.Attributes, value)
+	case schema.SingleNestedAttribute:
+		return objectValue(attribute.Attributes, value)
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := map[string]cty.Value{}
+		for key, element := range elements {
+			v, err := objectValue(attribute.NestedObject.Attributes, element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[key] = v
+		}
+		return cty.ObjectVal(values), nil
+	}
+
+	return ctyValue(value)
+}
+
+// nestedValues converts the value of a list or set of nested attributes.
+func nestedValues(attributes map[string]schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return cty.NilVal, err
+	}
+	if len(elements) == 0 {
+		return cty.EmptyTupleVal, nil
+	}
+
+	values := make([]cty.Value, 0, len(elements))
+	for _, element := range elements {
+		v, err := objectValue(attributes, element)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		values = append(values, v)
+	}
+
+	return cty.TupleVal(values), nil
+}
+
+// objectValue converts the value of a nested object, leaving out its null and computed attributes.
+func objectValue(attributes map[string]schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return cty.NilVal, err
+	}
+
+	values := map[string]cty.Value{}
+	for name, attribute := range attributes {
+		if !configurable(attribute) || fields[name].IsNull() {
+			continue
+		}
+
+		v, err := attributeValue(attribute, fields[name])
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("%s: %w", name, err)
+		}
+		values[name] = v
+	}
+
+	return cty.ObjectVal(values), nil
+}
+
+// ctyValue converts a Terraform value to the value written to the configuration. Collections are written as tuples
+// and objects, which have the same syntax as lists, sets and maps, so their element types do not matter.
+func ctyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	if !value.IsKnown() {
+		return cty.NilVal, fmt.Errorf("unknown value")
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		err := value.As(&s)
+		return cty.StringVal(s), err
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := value.As(&n)
+		return cty.NumberVal(n), err
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		err := value.As(&b)
+		return cty.BoolVal(b), err
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+
+		values := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			v, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values = append(values, v)
+		}
+		return cty.TupleVal(values), nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := map[string]cty.Value{}
+		for key, element := range elements {
+			v, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[key] = v
+		}
+		return cty.ObjectVal(values), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported type %s", value.Type())
+}
+
+// configurable reports whether an attribute can be set in the configuration.
+func configurable(attribute schema.Attribute) bool {
+	return attribute.IsRequired() || attribute.IsOptional()
+}
+
+// attributeNames returns the names of the attributes in the order they are written.
+func attributeNames(attributes map[string]schema.Attribute) []string {
+	return slices.SortedFunc(maps.Keys(attributes), func(a string, b string) int {
+		if attributes[a].IsRequired() != attributes[b].IsRequired() {
+			if attributes[a].IsRequired() {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+}
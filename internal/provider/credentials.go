@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiKeyCommandTimeout is how long the api_key_command may run. It leaves room for helpers prompting the user, such
+// as a password manager asking to be unlocked.
+const apiKeyCommandTimeout = 2 * time.Minute
+
+// consoleAPIKeyEnvVars are the environment variables the Console API key may be set with, in order of precedence.
+var consoleAPIKeyEnvVars = []string{"STATSIG_CONSOLE_KEY", "STATSIG_CONSOLE_API_KEY"}
+
+// consoleAPIKeyPattern matches the format of the Console API keys.
+var consoleAPIKeyPattern = regexp.MustCompile("^console-[a-zA-Z0-9]{3,}")
+
+// resolveConsoleAPIKey returns the Console API key, read from the first source that is set, in order:
+//
+//  1. the console_api_key, console_api_key_file or api_key_command attribute, which conflict with each other
+//  2. the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY environment variable
+//  3. the file named by the STATSIG_CONSOLE_API_KEY_FILE environment variable
+//
+// The key is never included in the diagnostics, which only name the source it was read from.
+func resolveConsoleAPIKey(ctx context.Context, config StatsigProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.ConsoleKey.IsUnknown() || config.ConsoleKeyFile.IsUnknown() || config.APIKeyCommand.IsUnknown() {
+		diags.AddError(
+			"Unknown Console API Key",
+			"The provider cannot create the Statsig API client as there is an unknown configuration value for the Statsig Console API Key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the STATSIG_CONSOLE_KEY environment variable.",
+		)
+		return "", diags
+	}
+
+	var attribute path.Path
+	var source string
+	var key string
+	var err error
+
+	switch {
+	case !config.ConsoleKey.IsNull():
+		attribute, source = path.Root("console_api_key"), "the console_api_key attribute"
+		key = config.ConsoleKey.ValueString()
+	case !config.ConsoleKeyFile.IsNull():
+		attribute, source = path.Root("console_api_key_file"), fmt.Sprintf("the console_api_key_file %q", config.ConsoleKeyFile.ValueString())
+		key, err = readAPIKeyFile(config.ConsoleKeyFile.ValueString())
+	case !config.APIKeyCommand.IsNull():
+		var command []string
+		diags.Append(config.APIKeyCommand.ElementsAs(ctx, &command, false)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		attribute, source = path.Root("api_key_command"), "the api_key_command"
+		key, err = runAPIKeyCommand(ctx, command)
+	default:
+		attribute = path.Root("console_api_key")
+		key, source, err = ConsoleAPIKeyFromEnv()
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Unable to Read Statsig Console API Key",
+			fmt.Sprintf("The provider cannot read the Statsig Console API Key from %s: %s", source, err),
+		)
+		return "", diags
+	}
+
+	if source == "" {
+		diags.AddAttributeError(
+			attribute,
+			"Missing Statsig Console API Key",
+			"The provider cannot create the Statsig API client as there is no value for the Statsig Console API Key. "+
+				"Set the console_api_key, console_api_key_file or api_key_command attribute, "+
+				"or use the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY_FILE environment variable.",
+		)
+		return "", diags
+	}
+
+	if key == "" {
+		diags.AddAttributeError(
+			attribute,
+			"Missing Statsig Console API Key",
+			fmt.Sprintf("The provider cannot create the Statsig API client as the Statsig Console API Key read from %s is empty.", source),
+		)
+		return "", diags
+	}
+
+	// The key itself is left out of the message, unlike the validators of the framework would do.
+	if !consoleAPIKeyPattern.MatchString(key) {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Statsig Console API Key",
+			fmt.Sprintf("The value read from %s is not a valid Statsig Console API Key, which starts with \"console-\".", source),
+		)
+		return "", diags
+	}
+
+	return key, diags
+}
+
+// ConsoleAPIKeyFromEnv returns the Console API key set in the environment: the STATSIG_CONSOLE_KEY or
+// STATSIG_CONSOLE_API_KEY environment variable, or else the content of the file named by the
+// STATSIG_CONSOLE_API_KEY_FILE environment variable.
+//
+// The source names where the key was read from, without the key itself. It is empty when none of the environment
+// variables is set.
+func ConsoleAPIKeyFromEnv() (key string, source string, err error) {
+	for _, name := range consoleAPIKeyEnvVars {
+		if value := os.Getenv(name); value != "" {
+			return value, "the " + name + " environment variable", nil
+		}
+	}
+
+	if file := os.Getenv("STATSIG_CONSOLE_API_KEY_FILE"); file != "" {
+		key, err := readAPIKeyFile(file)
+		return key, fmt.Sprintf("the STATSIG_CONSOLE_API_KEY_FILE %q", file), err
+	}
+
+	return "", "", nil
+}
+
+// readAPIKeyFile returns the key stored in a file, without the surrounding whitespace such as a final newline.
+func readAPIKeyFile(name string) (string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		// The source of the key already names the file, so only the cause is kept.
+		var pathErr *os.PathError
+		if errors.As(err, &pathErr) {
+			return "", pathErr.Err
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// runAPIKeyCommand runs a credential helper, such as the Vault or 1Password CLI, and returns the key it writes to its
+// standard output. The command is run directly rather than through a shell, and its standard error is included in
+// the error when it fails.
+func runAPIKeyCommand(ctx context.Context, command []string) (string, error) {
+	if len(command) == 0 || command[0] == "" {
+		return "", errors.New("the command is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("%s did not complete within %s", command[0], apiKeyCommandTimeout)
+		}
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return "", fmt.Errorf("%s failed: %w\n\n%s", command[0], err, output)
+		}
+		return "", fmt.Errorf("%s failed: %w", command[0], err)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
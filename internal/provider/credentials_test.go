@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveConsoleAPIKey(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential helpers of the test cases are shell commands")
+	}
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, []byte("console-fromfile\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	envKeyFile := filepath.Join(dir, "env_key")
+	if err := os.WriteFile(envKeyFile, []byte("console-fromenvfile"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	command := func(args ...string) types.List {
+		values := make([]attr.Value, 0, len(args))
+		for _, arg := range args {
+			values = append(values, types.StringValue(arg))
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	testCases := map[string]struct {
+		config   StatsigProviderModel
+		env      map[string]string
+		expected string
+		err      string
+	}{
+		"attribute": {
+			config:   StatsigProviderModel{ConsoleKey: types.StringValue("console-fromattribute")},
+			env:      map[string]string{"STATSIG_CONSOLE_KEY": "console-fromenv"},
+			expected: "console-fromattribute",
+		},
+		"file": {
+			config:   StatsigProviderModel{ConsoleKeyFile: types.StringValue(keyFile)},
+			env:      map[string]string{"STATSIG_CONSOLE_KEY": "console-fromenv"},
+			expected: "console-fromfile",
+		},
+		"command": {
+			config:   StatsigProviderModel{APIKeyCommand: command("sh", "-c", "echo console-fromcommand")},
+			env:      map[string]string{"STATSIG_CONSOLE_KEY": "console-fromenv"},
+			expected: "console-fromcommand",
+		},
+		"environment variable": {
+			env:      map[string]string{"STATSIG_CONSOLE_KEY": "console-fromenv", "STATSIG_CONSOLE_API_KEY": "console-fromalias"},
+			expected: "console-fromenv",
+		},
+		"environment variable alias": {
+			env:      map[string]string{"STATSIG_CONSOLE_API_KEY": "console-fromalias", "STATSIG_CONSOLE_API_KEY_FILE": envKeyFile},
+			expected: "console-fromalias",
+		},
+		"environment variable file": {
+			env:      map[string]string{"STATSIG_CONSOLE_API_KEY_FILE": envKeyFile},
+			expected: "console-fromenvfile",
+		},
+		"missing": {
+			err: "Missing Statsig Console API Key",
+		},
+		"empty attribute": {
+			config: StatsigProviderModel{ConsoleKey: types.StringValue("")},
+			env:    map[string]string{"STATSIG_CONSOLE_KEY": "console-fromenv"},
+			err:    "Missing Statsig Console API Key",
+		},
+		"missing file": {
+			config: StatsigProviderModel{ConsoleKeyFile: types.StringValue(filepath.Join(dir, "missing"))},
+			err:    "Unable to Read Statsig Console API Key",
+		},
+		"failing command": {
+			config: StatsigProviderModel{APIKeyCommand: command("sh", "-c", "echo vault is sealed >&2; exit 2")},
+			err:    "vault is sealed",
+		},
+		"unknown": {
+			config: StatsigProviderModel{ConsoleKeyFile: types.StringUnknown()},
+			err:    "Unknown Console API Key",
+		},
+		"invalid": {
+			config: StatsigProviderModel{APIKeyCommand: command("sh", "-c", "echo secret-fromcommand")},
+			err:    "Invalid Statsig Console API Key",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for _, name := range []string{"STATSIG_CONSOLE_KEY", "STATSIG_CONSOLE_API_KEY", "STATSIG_CONSOLE_API_KEY_FILE"} {
+				t.Setenv(name, testCase.env[name])
+			}
+
+			key, diags := resolveConsoleAPIKey(context.Background(), testCase.config)
+			if testCase.err == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				if key != testCase.expected {
+					t.Errorf("expected the key %q, got %q", testCase.expected, key)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatalf("expected an error, got the key %q", key)
+			}
+			var messages []string
+			for _, d := range diags.Errors() {
+				messages = append(messages, d.Summary()+": "+d.Detail())
+			}
+			message := strings.Join(messages, "\n")
+			if !strings.Contains(message, testCase.err) {
+				t.Errorf("expected an error containing %q, got: %s", testCase.err, message)
+			}
+			if strings.Contains(message, "secret-fromcommand") {
+				t.Errorf("expected the diagnostics not to contain the key, got: %s", message)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsigtest"
+)
+
+// The tests in this file check that an object deleted outside of Terraform is planned to be created again, rather
+// than failing the refresh. Every resource is covered.
+
+func TestAccTagResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+
+	resource.Test(t, testAccDisappearsTestCase(api, "tags", "statsig_tag.test", testAccTagDisappearsConfig("tf_acc_tag"),
+		resource.TestCheckResourceAttr("statsig_tag.test", "name", "tf_acc_tag"),
+		resource.TestCheckResourceAttrSet("statsig_tag.test", "id"),
+	))
+}
+
+func TestAccGateResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+
+	resource.Test(t, testAccDisappearsTestCase(api, "gates", "statsig_gate.test", testAccGateDisappearsConfig("tf_acc_gate")))
+}
+
+func TestAccDynamicConfigResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := testAccDynamicConfigResourceConfig("tf_acc_dynamic_config", `{"max_items": 10}`, `{"max_items": 50}`)
+
+	resource.Test(t, testAccDisappearsTestCase(api, "dynamic_configs", "statsig_dynamic_config.test", config))
+}
+
+func TestAccExperimentResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := testAccExperimentResourceConfig("tf_acc_experiment", "setup", "", 50)
+
+	resource.Test(t, testAccDisappearsTestCase(api, "experiments", "statsig_experiment.test", config))
+}
+
+func TestAccHoldoutResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_holdout" "test" {
+  name            = "tf_acc_holdout"
+  pass_percentage = 5
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "holdouts", "statsig_holdout.test", config))
+}
+
+func TestAccLayerResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_layer" "test" {
+  name = "tf_acc_layer"
+
+  parameter {
+    name          = "max_items"
+    type          = "number"
+    default_value = jsonencode(10)
+  }
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "layers", "statsig_layer.test", config))
+}
+
+func TestAccSegmentResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_segment" "test" {
+  name = "tf_acc_segment"
+  type = "id_list"
+  ids  = ["user-1", "user-2"]
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "segments", "statsig_segment.test", config,
+		resource.TestCheckResourceAttr("statsig_segment.test", "ids.#", "2"),
+	))
+}
+
+func TestAccMetricResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_metric" "test" {
+  name       = "tf_acc_metric"
+  type       = "event_count"
+  event_name = "purchase"
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "metrics", "statsig_metric.test", config))
+}
+
+func TestAccMetricSourceResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_metric_source" "test" {
+  name             = "tf_acc_metric_source"
+  sql              = "SELECT user_id, created_at FROM orders"
+  timestamp_column = "created_at"
+
+  id_type_mapping {
+    id_type = "userID"
+    column  = "user_id"
+  }
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "metric_sources", "statsig_metric_source.test", config))
+}
+
+func TestAccTargetAppResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_target_app" "test" {
+  name        = "tf_acc_target_app"
+  description = "created by an acceptance test"
+}
+`
+
+	resource.Test(t, testAccDisappearsTestCase(api, "target_apps", "statsig_target_app.test", config))
+}
+
+// TestAccTargetAppAssignmentResource_disappears checks that an assignment is planned to be created again when the
+// entity is removed from the target app outside of Terraform.
+func TestAccTargetAppAssignmentResource_disappears(t *testing.T) {
+	api := testAccServer(t)
+	config := `
+resource "statsig_gate" "test" {
+  name = "tf_acc_assigned_gate"
+}
+
+resource "statsig_target_app" "test" {
+  name        = "tf_acc_target_app"
+  description = "created by an acceptance test"
+}
+
+resource "statsig_target_app_assignment" "test" {
+  target_app_id = statsig_target_app.test.id
+  entity_type   = "gate"
+  entity_id     = statsig_gate.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFakeDestroy(api, "target_apps", "statsig_target_app"),
+		Steps: []resource.TestStep{
+			// The gate is removed from the target app in the console once it is assigned.
+			{
+				Config:             config,
+				Check:              testAccCheckAssignedGate(api, "statsig_target_app_assignment.test", true),
+				ExpectNonEmptyPlan: true,
+			},
+			// Applying the plan assigns the gate again.
+			{
+				Config: config,
+				Check:  testAccCheckAssignedGate(api, "statsig_target_app_assignment.test", false),
+			},
+		},
+	})
+}
+
+// testAccDisappearsTestCase returns a test case that deletes the object of the resource from the fake API once it
+// is created, and expects the refresh to remove it from the state, so the plan creates it again. Applying that
+// plan must create the object again. The checks are run after the object is first created.
+func testAccDisappearsTestCase(api *statsigtest.Server, collection string, resourceName string, config string, checks ...resource.TestCheckFunc) resource.TestCase {
+	resourceType, _, _ := strings.Cut(resourceName, ".")
+
+	return resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckFakeDestroy(api, collection, resourceType),
+		Steps: []resource.TestStep{
+			// The object is deleted in the console once it is created.
+			{
+				Config:             config,
+				Check:              resource.ComposeAggregateTestCheckFunc(append(checks, testAccCheckFakeDisappears(api, collection, resourceName))...),
+				ExpectNonEmptyPlan: true,
+			},
+			// Applying the plan creates the object again, so it can be destroyed at the end of the test.
+			{
+				Config: config,
+				Check:  testAccCheckFakeExists(api, collection, resourceName),
+			},
+		},
+	}
+}
+
+// testAccCheckFakeDisappears deletes the object of the resource from the fake API, as if it was deleted in the
+// console outside of Terraform.
+func testAccCheckFakeDisappears(api *statsigtest.Server, collection string, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in the state", resourceName)
+		}
+
+		key := testAccFakeKey(collection, rs)
+		if !api.Exists(collection, key) {
+			return fmt.Errorf("%s %s does not exist", resourceName, key)
+		}
+		api.Delete(collection, key)
+
+		return nil
+	}
+}
+
+// testAccCheckFakeExists checks that the fake API holds the object of the resource.
+func testAccCheckFakeExists(api *statsigtest.Server, collection string, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in the state", resourceName)
+		}
+
+		if key := testAccFakeKey(collection, rs); !api.Exists(collection, key) {
+			return fmt.Errorf("expected %s %s to be created again", resourceName, key)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAssignedGate checks that the gate of the assignment is a member of its target app in the fake API.
+// When remove is set, the gate is then removed from the target app, as if it was removed in the console.
+func testAccCheckAssignedGate(api *statsigtest.Server, resourceName string, remove bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in the state", resourceName)
+		}
+
+		targetAppID, gateID := rs.Primary.Attributes["target_app_id"], rs.Primary.Attributes["entity_id"]
+		targetApp := api.Get("target_apps", targetAppID)
+		gates, _ := targetApp["gates"].([]any)
+		if !slices.Contains(gates, any(gateID)) {
+			return fmt.Errorf("expected gate %s to be a member of target app %s, got %v", gateID, targetAppID, gates)
+		}
+		if !remove {
+			return nil
+		}
+
+		// The target app is replaced by a copy without the gate.
+		targetApp["gates"] = slices.DeleteFunc(gates, func(gate any) bool { return gate == gateID })
+		api.Delete("target_apps", targetAppID)
+		api.Put("target_apps", targetApp)
+
+		return nil
+	}
+}
+
+// testAccCheckFakeDestroy checks that every resource of the type was deleted from the fake API.
+func testAccCheckFakeDestroy(api *statsigtest.Server, collection string, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == resourceType && api.Exists(collection, testAccFakeKey(collection, rs)) {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccFakeKey returns the key of the object of a resource in the fake API. Tags and metric sources are looked up
+// by name, as the fake stores them.
+func testAccFakeKey(collection string, rs *terraform.ResourceState) string {
+	if collection == "tags" || collection == "metric_sources" {
+		return rs.Primary.Attributes["name"]
+	}
+
+	return rs.Primary.ID
+}
+
+func testAccTagDisappearsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "statsig_tag" "test" {
+  name        = %[1]q
+  description = "created by an acceptance test"
+}
+`, name)
+}
+
+func testAccGateDisappearsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "statsig_gate" "test" {
+  name = %[1]q
+}
+`, name)
+}
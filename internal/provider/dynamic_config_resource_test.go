@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAccDynamicConfigResource(t *testing.T) {
+	name := testAccName("tf_acc_config")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDynamicConfigDestroy(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDynamicConfigResourceConfig(name,
+					`{"theme": "light", "max_items": 10}`,
+					`{"theme": "dark", "max_items": 50}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_dynamic_config.test", "name", name),
+					resource.TestCheckResourceAttrSet("statsig_dynamic_config.test", "id"),
+					resource.TestCheckResourceAttr("statsig_dynamic_config.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("statsig_dynamic_config.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("statsig_dynamic_config.test", "rule.0.name", "beta testers"),
+					resource.TestCheckResourceAttrSet("statsig_dynamic_config.test", "rule.0.id"),
+				),
+			},
+			// The API returns the keys of the JSON values in another order than the configuration, which is
+			// not a change
+			{
+				Config: testAccDynamicConfigResourceConfig(name,
+					`{"theme": "light", "max_items": 10}`,
+					`{"theme": "dark", "max_items": 50}`,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDynamicConfigDefaultValue(t, "statsig_dynamic_config.test", `{"max_items":10,"theme":"light"}`),
+					resource.TestCheckResourceAttr("statsig_dynamic_config.test", "default_value", "{\"theme\": \"light\", \"max_items\": 10}\n"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDynamicConfigResourceConfig(name,
+					`{"theme": "light", "max_items": 20}`,
+					`{"theme": "dark", "max_items": 50}`,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("statsig_dynamic_config.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDynamicConfigDefaultValue(t, "statsig_dynamic_config.test", `{"max_items":20,"theme":"light"}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statsig_dynamic_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported JSON values are the ones returned by the API, which only differ in formatting.
+				ImportStateVerifyIgnore: []string{"default_value", "rule.0.return_value"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["default_value"] != `{"max_items":20,"theme":"light"}` {
+						return fmt.Errorf("expected the imported default value to be read from the API, got: %+v", states)
+					}
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckDynamicConfigDefaultValue checks the default value of the dynamic config in Statsig, compacted.
+func testAccCheckDynamicConfigDefaultValue(t *testing.T, resourceName string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		dynamicConfig, err := testAccClient(t).GetDynamicConfig(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to get dynamic config %s: %w", rs.Primary.ID, err)
+		}
+
+		if string(dynamicConfig.DefaultValue) != expected {
+			return fmt.Errorf("expected the default value %s, got: %s", expected, dynamicConfig.DefaultValue)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDynamicConfigDestroy checks that every dynamic config in the state was deleted from Statsig.
+func testAccCheckDynamicConfigDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "statsig_dynamic_config" {
+				continue
+			}
+
+			_, err := testAccClient(t).GetDynamicConfig(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("dynamic config %s still exists", rs.Primary.ID)
+			}
+
+			if !statsig.IsNotFound(err) {
+				return fmt.Errorf("unable to check dynamic config %s was deleted: %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDynamicConfigResourceConfig(name string, defaultValue string, returnValue string) string {
+	return fmt.Sprintf(`
+resource "statsig_dynamic_config" "test" {
+  name          = %[1]q
+  description   = "created by an acceptance test"
+  default_value = <<-EOT
+%[2]s
+EOT
+
+  rule {
+    name            = "beta testers"
+    pass_percentage = 100
+
+    condition {
+      type = "public"
+    }
+
+    return_value = <<-EOT
+%[3]s
+EOT
+  }
+}
+`, name, defaultValue, returnValue)
+}
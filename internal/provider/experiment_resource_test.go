@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAccExperimentResource(t *testing.T) {
+	name := testAccName("tf_acc_experiment")
+	locked := regexp.MustCompile(`Attribute Locked After Experiment Start`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentDestroy(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing, with empty metric lists
+			{
+				Config: testAccExperimentResourceConfig(name, "setup", `
+  primary_metrics   = []
+  secondary_metrics = []
+`, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_experiment.test", "name", name),
+					resource.TestCheckResourceAttrSet("statsig_experiment.test", "id"),
+					resource.TestCheckResourceAttr("statsig_experiment.test", "status", "setup"),
+					resource.TestCheckResourceAttr("statsig_experiment.test", "primary_metrics.#", "0"),
+					resource.TestCheckResourceAttr("statsig_experiment.test", "secondary_metrics.#", "0"),
+					resource.TestCheckResourceAttr("statsig_experiment.test", "group.#", "2"),
+				),
+			},
+			// Adding and removing the layer while the experiment is in setup
+			{
+				Config: testAccExperimentResourceConfig(name, "setup", `  layer_id = statsig_layer.test.id`, 50) + testAccExperimentLayerConfig(name),
+				Check:  resource.TestCheckResourceAttrPair("statsig_experiment.test", "layer_id", "statsig_layer.test", "id"),
+			},
+			{
+				Config: testAccExperimentResourceConfig(name, "setup", "", 50) + testAccExperimentLayerConfig(name),
+				Check:  resource.TestCheckNoResourceAttr("statsig_experiment.test", "layer_id"),
+			},
+			// Starting the experiment, with the metrics left unset
+			{
+				Config: testAccExperimentResourceConfig(name, "active", "", 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_experiment.test", "status", "active"),
+					resource.TestCheckResourceAttr("statsig_experiment.test", "primary_metrics.#", "0"),
+				),
+			},
+			// The ID type, layer and groups are locked once the experiment has started
+			{
+				Config:      testAccExperimentResourceConfig(name, "active", `  id_type = "stableID"`, 50),
+				ExpectError: locked,
+			},
+			{
+				Config:      testAccExperimentResourceConfig(name, "active", `  layer_id = "pricing"`, 50),
+				ExpectError: locked,
+			},
+			{
+				Config:      testAccExperimentResourceConfig(name, "active", "", 40),
+				ExpectError: locked,
+			},
+			// The status cannot move backwards
+			{
+				Config:      testAccExperimentResourceConfig(name, "setup", "", 50),
+				ExpectError: regexp.MustCompile(`Invalid Experiment Status Change`),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statsig_experiment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckExperimentDestroy checks that every experiment in the state was deleted from Statsig.
+func testAccCheckExperimentDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "statsig_experiment" {
+				continue
+			}
+
+			_, err := testAccClient(t).GetExperiment(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("experiment %s still exists", rs.Primary.ID)
+			}
+
+			if !statsig.IsNotFound(err) {
+				return fmt.Errorf("unable to check experiment %s was deleted: %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccExperimentResourceConfig(name string, status string, body string, controlSize int) string {
+	return fmt.Sprintf(`
+resource "statsig_experiment" "test" {
+  name        = %[1]q
+  description = "created by an acceptance test"
+  status      = %[2]q
+%[3]s
+
+  group {
+    name             = "control"
+    size             = %[4]d
+    parameter_values = jsonencode({ max_items = 10 })
+  }
+
+  group {
+    name             = "test"
+    size             = %[5]d
+    parameter_values = jsonencode({ max_items = 50 })
+  }
+}
+`, name, status, body, controlSize, 100-controlSize)
+}
+
+// testAccExperimentLayerConfig declares a layer the experiment can be added to.
+func testAccExperimentLayerConfig(name string) string {
+	return fmt.Sprintf(`
+resource "statsig_layer" "test" {
+  name = "%[1]s_layer"
+
+  parameter {
+    name          = "max_items"
+    type          = "number"
+    default_value = jsonencode(10)
+  }
+}
+`, name)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAccGateResource(t *testing.T) {
+	name := testAccName("tf_acc_gate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckGateDestroy(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGateResourceConfig(name, `
+  tags        = [statsig_tag.first.name]
+  target_apps = []
+
+  rule {
+    name            = "employees"
+    pass_percentage = 100
+
+    condition {
+      type         = "email"
+      operator     = "str_contains_any"
+      target_value = ["@example.com"]
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_gate.test", "name", name),
+					resource.TestCheckResourceAttr("statsig_gate.test", "description", "created by an acceptance test"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttrSet("statsig_gate.test", "id"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("statsig_gate.test", "tags.*", "statsig_tag.first", "name"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "target_apps.#", "0"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.#", "1"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.0.name", "employees"),
+					resource.TestCheckResourceAttrSet("statsig_gate.test", "rule.0.id"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.0.condition.0.target_value.0", "@example.com"),
+				),
+			},
+			// Update and Read testing of the rules, tags and target apps
+			{
+				Config: testAccGateResourceConfig(name, `
+  tags        = [statsig_tag.second.name]
+  target_apps = [statsig_target_app.test.id]
+
+  rule {
+    name            = "beta"
+    pass_percentage = 50
+    environments    = ["staging"]
+
+    condition {
+      type = "public"
+    }
+  }
+
+  rule {
+    name            = "employees"
+    pass_percentage = 100
+
+    condition {
+      type         = "email"
+      operator     = "str_contains_any"
+      target_value = ["@example.com", "@example.org"]
+    }
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_gate.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("statsig_gate.test", "tags.*", "statsig_tag.second", "name"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "target_apps.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("statsig_gate.test", "target_apps.*", "statsig_target_app.test", "id"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.0.name", "beta"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.0.environments.#", "1"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.1.name", "employees"),
+					resource.TestCheckResourceAttr("statsig_gate.test", "rule.1.condition.0.target_value.#", "2"),
+					testAccCheckGateRules(t, "statsig_gate.test", "beta", "employees"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statsig_gate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckGateRules checks the names of the rules of the gate in Statsig, in evaluation order.
+func testAccCheckGateRules(t *testing.T, resourceName string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		gate, err := testAccClient(t).GetGate(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to get gate %s: %w", rs.Primary.ID, err)
+		}
+
+		if len(gate.Rules) != len(names) {
+			return fmt.Errorf("expected %d rules, got: %+v", len(names), gate.Rules)
+		}
+		for i, name := range names {
+			if gate.Rules[i].Name != name {
+				return fmt.Errorf("expected rule %d to be %q, got: %q", i, name, gate.Rules[i].Name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckGateDestroy checks that every gate in the state was deleted from Statsig.
+func testAccCheckGateDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "statsig_gate" {
+				continue
+			}
+
+			_, err := testAccClient(t).GetGate(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("gate %s still exists", rs.Primary.ID)
+			}
+
+			if !statsig.IsNotFound(err) {
+				return fmt.Errorf("unable to check gate %s was deleted: %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccGateResourceConfig(name string, body string) string {
+	return fmt.Sprintf(`
+resource "statsig_tag" "first" {
+  name        = "%[1]s_first"
+  description = "created by an acceptance test"
+}
+
+resource "statsig_tag" "second" {
+  name        = "%[1]s_second"
+  description = "created by an acceptance test"
+}
+
+resource "statsig_target_app" "test" {
+  name        = %[1]q
+  description = "created by an acceptance test"
+}
+
+resource "statsig_gate" "test" {
+  name        = %[1]q
+  description = "created by an acceptance test"
+%[2]s}
+`, name, body)
+}
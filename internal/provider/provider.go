@@ -2,15 +2,31 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
-	"regexp"
+	"strings"
+	"time"
 
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/dynamic_configs"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/experiments"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/gates"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/holdouts"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/layers"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/metric_sources"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/metrics"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/segments"
 	"github.com/useless-solutions/terraform-provider-statsig/internal/service/tags"
 	"github.com/useless-solutions/terraform-provider-statsig/internal/service/target_apps"
 	client "github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,7 +37,10 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &StatsigProvider{}
+var (
+	_ provider.Provider                  = &StatsigProvider{}
+	_ provider.ProviderWithListResources = &StatsigProvider{}
+)
 
 // StatsigProvider is the provider implementation.
 type StatsigProvider struct {
@@ -29,11 +48,23 @@ type StatsigProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// transport replaces the transport of the HTTP client of the Statsig client when set, so that acceptance
+	// tests can record and replay the requests sent to the Statsig API.
+	transport http.RoundTripper
 }
 
 // StatsigProviderModel describes the provider data model.
 type StatsigProviderModel struct {
-	ConsoleKey types.String `tfsdk:"console_api_key"`
+	ConsoleKey        types.String  `tfsdk:"console_api_key"`
+	ConsoleKeyFile    types.String  `tfsdk:"console_api_key_file"`
+	APIKeyCommand     types.List    `tfsdk:"api_key_command"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	APIURL            types.String  `tfsdk:"api_url"`
+	HTTPTimeout       types.String  `tfsdk:"http_timeout"`
+	ExtraHeaders      types.Map     `tfsdk:"extra_headers"`
 }
 
 // Metadata returns the provider type name.
@@ -46,14 +77,79 @@ func (p *StatsigProvider) Metadata(_ context.Context, _ provider.MetadataRequest
 // This should include an API token and endpoint.
 func (p *StatsigProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "The Statsig provider manages the objects of a Statsig project with the Console API. " +
+			"The Console API Key is read from the first source that is set: the console_api_key, console_api_key_file or api_key_command attribute, " +
+			"which conflict with each other, then the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY environment variable, " +
+			"then the file named by the STATSIG_CONSOLE_API_KEY_FILE environment variable.",
 		Attributes: map[string]schema.Attribute{
 			"console_api_key": schema.StringAttribute{
-				Required:    true,
-				Description: "A Statsig Console API Key",
+				Optional:  true,
+				Sensitive: true,
+				Description: "A Statsig Console API Key. May also be set with the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY environment variable. " +
+					"Conflicts with console_api_key_file and api_key_command.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("console_api_key_file"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"console_api_key_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path of a file holding the Statsig Console API Key. Surrounding whitespace is ignored. " +
+					"May also be set with the STATSIG_CONSOLE_API_KEY_FILE environment variable, which is used when no other source of the key is set. " +
+					"Conflicts with console_api_key and api_key_command.",
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(regexp.MustCompile("^console-[a-zA-Z0-9]{3,}"), "Provided key is not a valid Console API key"),
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("console_api_key"), path.MatchRoot("api_key_command")),
+				},
+			},
+			"api_key_command": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "A credential helper printing the Statsig Console API Key to its standard output, such as the Vault or 1Password CLI, " +
+					"given as the program and its arguments. The program is run without a shell. Surrounding whitespace is ignored. " +
+					"Conflicts with console_api_key and console_api_key_file.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("console_api_key"), path.MatchRoot("console_api_key_file")),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "The number of times a request is retried when the Statsig API is rate limiting requests or returns a server error. " +
+					"Server errors are only retried for idempotent requests. Defaults to 3.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional: true,
+				Description: "The maximum time to wait between two attempts of a request, as a duration such as \"30s\" or \"1m\". " +
+					"Requests are not retried when the Statsig API asks to wait longer. Defaults to \"30s\".",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "The maximum number of requests per second sent to the Statsig API, shared by every resource of the provider. " +
+					"Requests above the limit are queued. Set to 0 to disable the limit. Defaults to 10.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
 				},
 			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+				Description: "The base URL of the Statsig Console API, such as a regional endpoint or a local mock server. " +
+					"May also be set with the STATSIG_API_URL environment variable. Defaults to \"" + client.DefaultHostURL + "\".",
+			},
+			"http_timeout": schema.StringAttribute{
+				Optional: true,
+				Description: "The time limit of a single request to the Statsig API, as a duration such as \"10s\" or \"1m\". " +
+					"May also be set with the STATSIG_HTTP_TIMEOUT environment variable. Defaults to \"10s\".",
+			},
+			"extra_headers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional headers sent with every request to the Statsig API, such as the headers required by an egress proxy. " +
+					"The headers cannot replace the API key. May also be set with the STATSIG_EXTRA_HEADERS environment variable, " +
+					"as a comma-separated list of name=value pairs.",
+			},
 		},
 	}
 }
@@ -67,36 +163,75 @@ func (p *StatsigProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	if config.ConsoleKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("console_api_key"),
-			"Unknown Console API Key",
-			"The provider cannot create the Statsig API client as there is an unknown configuration value for the Statsig Console API Key. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the STATSIG_CONSOLE_KEY environment variable.",
-		)
+	consoleAPIKey, diags := resolveConsoleAPIKey(ctx, config)
+	resp.Diagnostics.Append(diags...)
+
+	retry := client.DefaultRetryConfig()
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		maxWait, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || maxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("The retry_max_wait value must be a positive duration, such as \"30s\" or \"1m\", got: %q", config.RetryMaxWait.ValueString()),
+			)
+		}
+		retry.MaxWait = maxWait
+		retry.MinWait = min(retry.MinWait, maxWait)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	limiter := client.NewLimiter(client.DefaultRequestsPerSecond)
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		limiter = client.NewLimiter(config.RequestsPerSecond.ValueFloat64())
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	apiURL := client.DefaultHostURL
+	if value := os.Getenv("STATSIG_API_URL"); value != "" {
+		apiURL = value
+	}
+	if !config.APIURL.IsNull() && !config.APIURL.IsUnknown() {
+		apiURL = config.APIURL.ValueString()
+	}
+	if parsed, err := url.Parse(apiURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid API URL",
+			fmt.Sprintf("The api_url value must be an absolute http or https URL, such as %q, got: %q", client.DefaultHostURL, apiURL),
+		)
+	}
 
-	consoleAPIKey := os.Getenv("STATSIG_CONSOLE_KEY")
-	if !config.ConsoleKey.IsNull() {
-		consoleAPIKey = config.ConsoleKey.ValueString()
+	httpTimeout := client.DefaultHTTPTimeout
+	httpTimeoutValue := os.Getenv("STATSIG_HTTP_TIMEOUT")
+	if !config.HTTPTimeout.IsNull() && !config.HTTPTimeout.IsUnknown() {
+		httpTimeoutValue = config.HTTPTimeout.ValueString()
+	}
+	if httpTimeoutValue != "" {
+		timeout, err := time.ParseDuration(httpTimeoutValue)
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_timeout"),
+				"Invalid HTTP Timeout",
+				fmt.Sprintf("The http_timeout value must be a positive duration, such as \"10s\" or \"1m\", got: %q", httpTimeoutValue),
+			)
+		}
+		httpTimeout = timeout
 	}
 
-	if consoleAPIKey == "" {
+	extraHeaders, err := parseExtraHeaders(os.Getenv("STATSIG_EXTRA_HEADERS"))
+	if err != nil {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("console_api_key"),
-			"Missing Statsig Console API Key",
-			"The provider cannot create the Statsig API client as there is a missing or empty value for the Statsig Console API Key. "+
-				"Set the key value in the configuration or use the STATSIG_CONSOLE_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			path.Root("extra_headers"),
+			"Invalid Extra Headers",
+			fmt.Sprintf("The STATSIG_EXTRA_HEADERS environment variable must be a comma-separated list of name=value pairs: %s", err),
 		)
 	}
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		extraHeaders = map[string]string{}
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -107,7 +242,7 @@ func (p *StatsigProvider) Configure(ctx context.Context, req provider.ConfigureR
 	tflog.Debug(ctx, "Creating Statsig API Client")
 
 	// Create a new Statsig client using the configuration values
-	client, err := client.NewDeprecatedClient(ctx, consoleAPIKey)
+	client, err := client.NewAPIClient(ctx, consoleAPIKey)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Statsig API Client",
@@ -118,10 +253,20 @@ func (p *StatsigProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	client.HostURL = strings.TrimSuffix(apiURL, "/")
+	client.Client.Timeout = httpTimeout
+	if p.transport != nil {
+		client.Client.Transport = p.transport
+	}
+	client.Headers = extraHeaders
+	client.Retry = retry
+	client.Limiter = limiter
+
 	// Make the Statsig client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 
 	tflog.Info(ctx, "Configured Statsig provider", map[string]any{"success": true})
 }
@@ -131,6 +276,32 @@ func (p *StatsigProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		tags.NewTagResource,
 		target_apps.NewTargetAppResource,
+		target_apps.NewTargetAppAssignmentResource,
+		gates.NewGateResource,
+		dynamic_configs.NewDynamicConfigResource,
+		experiments.NewExperimentResource,
+		layers.NewLayerResource,
+		segments.NewSegmentResource,
+		holdouts.NewHoldoutResource,
+		metrics.NewMetricResource,
+		metric_sources.NewMetricSourceResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, which `terraform query` uses to discover
+// the existing objects of the Statsig Project.
+func (p *StatsigProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		tags.NewTagListResource,
+		target_apps.NewTargetAppListResource,
+		gates.NewGateListResource,
+		dynamic_configs.NewDynamicConfigListResource,
+		experiments.NewExperimentListResource,
+		layers.NewLayerListResource,
+		segments.NewSegmentListResource,
+		holdouts.NewHoldoutListResource,
+		metrics.NewMetricListResource,
+		metric_sources.NewMetricSourceListResource,
 	}
 }
 
@@ -150,3 +321,24 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// parseExtraHeaders parses headers from a comma-separated list of name=value pairs, such as
+// "X-Proxy-Authorization=token,X-Team=growth".
+func parseExtraHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	if strings.TrimSpace(value) == "" {
+		return headers, nil
+	}
+
+	// The values are left out of the errors, as they may hold credentials.
+	for i, pair := range strings.Split(value, ",") {
+		name, headerValue, found := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("entry %d is not a name=value pair", i+1)
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+
+	return headers, nil
+}
@@ -4,22 +4,189 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsigtest"
 )
 
+// testAccProvider is the provider instance served to Terraform during acceptance testing. It is shared by
+// every test, so testAccPreCheck can set the transport used to record and replay the requests.
+var testAccProvider = &StatsigProvider{version: "test"}
+
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"statsig":     providerserver.NewProtocol6WithError(testAccProvider),
 }
 
+// testAccPreCheck sets up the Console API the acceptance test runs against, depending on STATSIG_TEST_MODE:
+//   - by default, a fake Console API, see testAccServer.
+//   - "live", the Statsig project the STATSIG_CONSOLE_KEY belongs to.
+//   - "record", the same as "live", with the requests recorded to the cassette of the test.
+//   - "replay", the requests answered from the cassette of the test, without network access.
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	mode, err := statsigtest.ModeFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switch mode {
+	case statsigtest.ModeFake:
+		testAccServer(t)
+		return
+	case statsigtest.ModeReplay:
+		t.Setenv("STATSIG_CONSOLE_KEY", statsigtest.ConsoleKey)
+	}
+
+	// The live acceptance tests create real objects in the Statsig project the Console API key belongs to.
+	if os.Getenv("STATSIG_CONSOLE_KEY") == "" {
+		t.Fatal("STATSIG_CONSOLE_KEY must be set for live acceptance tests")
+	}
+
+	if mode == statsigtest.ModeLive {
+		return
+	}
+
+	recorder, err := statsigtest.NewRecorder(mode, filepath.Join("testdata", "cassettes", t.Name()+".json"))
+	if statsigtest.IsCassetteNotFound(err) {
+		t.Skipf("no cassette recorded for %s, run the test with STATSIG_TEST_MODE=record first", t.Name())
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccProvider.transport = recorder
+	t.Cleanup(func() {
+		testAccProvider.transport = nil
+		if err := recorder.Save(); err != nil {
+			t.Errorf("unable to save cassette: %s", err)
+		}
+	})
+}
+
+// testAccServer starts a fake Console API for the duration of the test, and points the provider and
+// testAccClient to it through the environment.
+func testAccServer(t *testing.T) *statsigtest.Server {
+	server := statsigtest.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv("STATSIG_API_URL", server.APIURL())
+	t.Setenv("STATSIG_CONSOLE_KEY", statsigtest.ConsoleKey)
+
+	return server
+}
+
+// testAccName returns a random name with the prefix, so that the objects of acceptance tests running at the same
+// time do not collide. When recording or replaying, the name is fixed, so the requests match the cassette.
+func testAccName(prefix string) string {
+	if mode, _ := statsigtest.ModeFromEnv(); mode == statsigtest.ModeRecord || mode == statsigtest.ModeReplay {
+		return prefix + "_recorded"
+	}
+
+	return acctest.RandomWithPrefix(prefix)
+}
+
+// testAccCheckImportedByName checks that importing an object by its name set its ID, rather than the name, as the
+// id of the imported state. ImportStateVerify then matches the imported state to the state by that ID.
+func testAccCheckImportedByName(name string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+
+		state := states[0]
+		if state.Attributes["name"] != name {
+			return fmt.Errorf("expected the name %q, got %q", name, state.Attributes["name"])
+		}
+		if state.ID == "" || state.ID == name {
+			return fmt.Errorf("expected the ID to be resolved from the name %q, got %q", name, state.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccClient returns a Statsig API client, used by acceptance tests to check the remote objects
+// directly rather than through the provider.
+func testAccClient(t *testing.T) *statsig.Client {
+	client, err := statsig.NewAPIClient(context.Background(), os.Getenv("STATSIG_CONSOLE_KEY"))
+	if err != nil {
+		t.Fatalf("unable to create Statsig API client: %s", err)
+	}
+	if apiURL := os.Getenv("STATSIG_API_URL"); apiURL != "" {
+		client.HostURL = apiURL
+	}
+	if testAccProvider.transport != nil {
+		client.Client.Transport = testAccProvider.transport
+	}
+
+	return client
+}
+
+func TestParseExtraHeaders(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected map[string]string
+		err      bool
+	}{
+		"empty":          {value: "", expected: map[string]string{}},
+		"single header":  {value: "X-Team=growth", expected: map[string]string{"X-Team": "growth"}},
+		"several values": {value: "X-Team=growth, X-Proxy-Authorization=a=b", expected: map[string]string{"X-Team": "growth", "X-Proxy-Authorization": "a=b"}},
+		"missing value":  {value: "X-Team", err: true},
+		"missing name":   {value: "=growth", err: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			headers, err := parseExtraHeaders(testCase.value)
+			if testCase.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !maps.Equal(headers, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, headers)
+			}
+		})
+	}
+}
+
+func TestProviderListResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+
+	// Every resource managing an object of the Statsig Project can be discovered with a list resource.
+	for typeName := range resp.ResourceSchemas {
+		if _, ok := resp.ListResourceSchemas[typeName]; !ok && typeName != "statsig_target_app_assignment" {
+			t.Errorf("expected a list resource for %s", typeName)
+		}
+	}
 }
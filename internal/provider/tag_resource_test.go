@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAccTagResource(t *testing.T) {
+	name := testAccName("tf_acc_tag")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTagDestroy(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTagResourceConfig(name, "created by an acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_tag.test", "name", name),
+					resource.TestCheckResourceAttr("statsig_tag.test", "description", "created by an acceptance test"),
+					resource.TestCheckResourceAttr("statsig_tag.test", "is_core", "false"),
+					resource.TestCheckResourceAttrSet("statsig_tag.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTagResourceConfig(name, "updated by an acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_tag.test", "description", "updated by an acceptance test"),
+				),
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "statsig_tag.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateCheck:  testAccCheckImportedByName(name),
+				ImportStateVerify: true,
+			},
+			// ImportState testing by ID
+			{
+				ResourceName:      "statsig_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTagListResource(t *testing.T) {
+	name := testAccName("tf_acc_tag_list")
+	byName := queryfilter.ByDisplayName(knownvalue.StringExact(name))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckTagDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourceConfig(name, "listed by an acceptance test"),
+			},
+			// Query testing
+			{
+				Query: true,
+				Config: `
+provider "statsig" {}
+
+list "statsig_tag" "test" {
+  provider         = statsig
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("statsig_tag.test", 1),
+					querycheck.ExpectResourceKnownValues("statsig_tag.test", byName, []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact(name)},
+						{Path: tfjsonpath.New("description"), KnownValue: knownvalue.StringExact("listed by an acceptance test")},
+					}),
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckTagDestroy checks that every tag in the state was deleted from Statsig.
+func testAccCheckTagDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "statsig_tag" {
+				continue
+			}
+
+			_, err := testAccClient(t).GetTag(context.Background(), rs.Primary.Attributes["name"])
+			if err == nil {
+				return fmt.Errorf("tag %s still exists", rs.Primary.Attributes["name"])
+			}
+
+			if !statsig.IsNotFound(err) {
+				return fmt.Errorf("unable to check tag %s was deleted: %w", rs.Primary.Attributes["name"], err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTagResourceConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "statsig_tag" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, name, description)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAccTargetAppResource(t *testing.T) {
+	name := testAccName("tf_acc_target_app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckTargetAppDestroy(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTargetAppResourceConfig(name, "created by an acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_target_app.test", "name", name),
+					resource.TestCheckResourceAttr("statsig_target_app.test", "description", "created by an acceptance test"),
+					resource.TestCheckResourceAttrSet("statsig_target_app.test", "id"),
+					testAccCheckTargetAppDescription(t, "statsig_target_app.test", "created by an acceptance test"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccTargetAppResourceConfig(name, "updated by an acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("statsig_target_app.test", "description", "updated by an acceptance test"),
+					testAccCheckTargetAppDescription(t, "statsig_target_app.test", "updated by an acceptance test"),
+				),
+			},
+			// ImportState testing by ID
+			{
+				ResourceName:      "statsig_target_app.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "statsig_target_app.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateCheck:  testAccCheckImportedByName(name),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckTargetAppDescription checks the description of the target app in Statsig, to make sure
+// the change was applied remotely and not only to the state.
+func testAccCheckTargetAppDescription(t *testing.T, resourceName string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		targetApp, err := testAccClient(t).GetTargetApp(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to get target app %s: %w", rs.Primary.Attributes["name"], err)
+		}
+
+		if targetApp.Description != description {
+			return fmt.Errorf("expected target app description %q, got: %q", description, targetApp.Description)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTargetAppDestroy checks that every target app in the state was deleted from Statsig.
+func testAccCheckTargetAppDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "statsig_target_app" {
+				continue
+			}
+
+			_, err := testAccClient(t).GetTargetApp(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("target app %s still exists", rs.Primary.Attributes["name"])
+			}
+
+			if !statsig.IsNotFound(err) {
+				return fmt.Errorf("unable to check target app %s was deleted: %w", rs.Primary.Attributes["name"], err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTargetAppResourceConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "statsig_target_app" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, name, description)
+}
+
+func TestAccTargetAppAssignmentResource(t *testing.T) {
+	name := testAccName("tf_acc_target_app")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTargetAppAssignmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("statsig_target_app_assignment.test", "target_app_id", "statsig_target_app.test", "id"),
+					testAccCheckTargetAppAssignmentID("statsig_target_app_assignment.test", "statsig_target_app.test", "statsig_gate.test"),
+				),
+			},
+			// Refresh the target_app, so its members include the assigned gate
+			{
+				Config: testAccTargetAppAssignmentResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("statsig_target_app.test", "gates.*", "statsig_gate.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "statsig_target_app_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckTargetAppAssignmentID checks that the ID of the assignment is made of the IDs of its target_app and
+// gate, rather than their names.
+func testAccCheckTargetAppAssignmentID(assignment string, targetApp string, gate string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resources := s.RootModule().Resources
+		for _, name := range []string{assignment, targetApp, gate} {
+			if _, ok := resources[name]; !ok {
+				return fmt.Errorf("resource %s not found in the state", name)
+			}
+		}
+
+		expected := resources[targetApp].Primary.ID + "/gate/" + resources[gate].Primary.ID
+		if id := resources[assignment].Primary.ID; id != expected {
+			return fmt.Errorf("expected the ID %s, got %s", expected, id)
+		}
+
+		return nil
+	}
+}
+
+func testAccTargetAppAssignmentResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "statsig_target_app" "test" {
+  name        = %[1]q
+  description = "created by an acceptance test"
+}
+
+resource "statsig_gate" "test" {
+  name = %[1]q
+}
+
+resource "statsig_target_app_assignment" "test" {
+  target_app_id = statsig_target_app.test.id
+  entity_type   = "gate"
+  entity_id     = statsig_gate.test.id
+}
+`, name)
+}
@@ -0,0 +1,85 @@
+// Package commontest provides the fixtures shared by the unit tests of the resources.
+package commontest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Resource holds the schemas of a resource, to build the requests and responses of its methods.
+type Resource struct {
+	Schema         schema.Schema
+	IdentitySchema identityschema.Schema
+}
+
+// NewResource returns the schemas of the resource. The identity schema is empty when the resource has no identity.
+func NewResource(t *testing.T, r resource.Resource) Resource {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	if withIdentity, ok := r.(resource.ResourceWithIdentity); ok {
+		withIdentity.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+		if identitySchemaResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", identitySchemaResp.Diagnostics)
+		}
+	}
+
+	return Resource{Schema: schemaResp.Schema, IdentitySchema: identitySchemaResp.IdentitySchema}
+}
+
+// Type returns the Terraform type of the resource.
+func (r Resource) Type() tftypes.Object {
+	return r.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+}
+
+// Value returns a value of the resource, with the attributes that are not in values set to null.
+func (r Resource) Value(values map[string]tftypes.Value) tftypes.Value {
+	return ObjectValue(r.Type(), values)
+}
+
+// NullState returns a null state of the resource, for the responses of the methods that set the state.
+func (r Resource) NullState() tfsdk.State {
+	return tfsdk.State{Schema: r.Schema, Raw: tftypes.NewValue(r.Type(), nil)}
+}
+
+// NullIdentity returns a null identity of the resource, for the responses of the methods that set the identity.
+func (r Resource) NullIdentity() *tfsdk.ResourceIdentity {
+	identityType := r.IdentitySchema.Type().TerraformType(context.Background())
+	return &tfsdk.ResourceIdentity{Schema: r.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)}
+}
+
+// ObjectValue returns an object of the given type, with the attributes that are not in values set to null.
+func ObjectValue(typ tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range typ.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	return tftypes.NewValue(typ, attributes)
+}
+
+// StringSetValue returns a set of strings.
+func StringSetValue(values ...string) tftypes.Value {
+	elements := make([]tftypes.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, tftypes.NewValue(tftypes.String, value))
+	}
+
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+}
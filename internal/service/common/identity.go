@@ -0,0 +1,25 @@
+package common
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceIdentity is the identity of a resource, which Terraform uses to import it and to match the results of
+// its list resource with the resources of the configuration.
+type ResourceIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// IdentitySchema returns the schema of the ResourceIdentity. The ID of the objects is immutable in the Statsig
+// API, so the identity of a resource never changes.
+func IdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the object in the Statsig project.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListResults streams the objects returned by the API as the results of a list resource.
+//
+// Each result is identified with the ID and named with the display name returned by describe. The resource model
+// returned by toResource is only built when Terraform requests the full resources, such as when generating their
+// configuration, as it may send additional requests.
+func ListResults[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	objects []T,
+	describe func(T) (id string, displayName string),
+	toResource func(context.Context, T) (any, diag.Diagnostics),
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for _, object := range objects {
+			id, displayName := describe(object)
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, ResourceIdentity{ID: types.StringValue(id)})...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				resource, diags := toResource(ctx, object)
+				result.Diagnostics.Append(diags...)
+				if !diags.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, resource)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// ListError returns the results of a list resource that failed to retrieve the objects from the API.
+func ListError(err error) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(
+		"Client Error",
+		"Unable to list the objects of the Statsig project, got error: "+err.Error(),
+	)
+
+	return list.ListResultsStreamDiagnostics(diags)
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringSetValue converts a string slice into a set. The API omits empty lists, so a nil slice is
+// converted into an empty set rather than a null one to match the schema defaults.
+func StringSetValue(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// StringSetElements converts a set into a string slice. Null and unknown sets are converted into an
+// empty slice, so the API receives an empty list rather than null.
+func StringSetElements(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	values := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+
+	diags := set.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// StringValueOrNull converts an empty string into a null value, as that is how optional attributes are
+// represented when omitted from the configuration.
+func StringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout is how long an operation of a resource may take when no timeout is set in its timeouts block.
+// It leaves room for requests that are retried after being rate limited.
+const DefaultTimeout = 5 * time.Minute
+
+// TimeoutsBlock returns the timeouts block of a resource, which configures the create, read, update and
+// delete timeouts.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// NullTimeouts returns the value of a timeouts block that is not set, for the resources built outside of a plan, such
+// as the results of a list resource.
+func NullTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})}
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jsonObjectValidator{}
+
+// JSONObject returns a validator which ensures the value is a JSON-encoded object. Statsig only accepts
+// objects as the values of dynamic configs and experiment parameters.
+func JSONObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON-encoded object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value); err != nil || value == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			"The value must be a JSON-encoded object, such as the output of jsonencode({ key = \"value\" }). "+
+				"Got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
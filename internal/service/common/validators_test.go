@@ -0,0 +1,43 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONObjectValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"null":         {value: types.StringNull()},
+		"unknown":      {value: types.StringUnknown()},
+		"empty object": {value: types.StringValue(`{}`)},
+		"object":       {value: types.StringValue(`{"enabled": true, "limits": {"max": 10}}`)},
+		"invalid json": {value: types.StringValue(`{"enabled": true`), expectError: true},
+		"json array":   {value: types.StringValue(`[1, 2]`), expectError: true},
+		"json string":  {value: types.StringValue(`"value"`), expectError: true},
+		"json null":    {value: types.StringValue(`null`), expectError: true},
+		"empty string": {value: types.StringValue(``), expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("default_value"),
+				ConfigValue: testCase.value,
+			}
+			resp := &validator.StringResponse{}
+
+			JSONObject().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package dynamic_configs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &DynamicConfigListResource{}
+	_ list.ListResourceWithConfigure = &DynamicConfigListResource{}
+)
+
+func NewDynamicConfigListResource() list.ListResource {
+	return &DynamicConfigListResource{}
+}
+
+// DynamicConfigListResource lists the dynamic configs of the Statsig Project, so they can be imported with `terraform query`.
+type DynamicConfigListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *DynamicConfigListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_config"
+}
+
+func (r *DynamicConfigListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the dynamic configs in the Statsig Project.",
+	}
+}
+
+func (r *DynamicConfigListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every dynamic config of the project, up to the limit of the query.
+func (r *DynamicConfigListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	dynamicConfigs, err := r.client.GetDynamicConfigs(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, dynamicConfigs,
+		func(dynamicConfig statsig.DynamicConfigAPIRequest) (string, string) {
+			return dynamicConfig.ID, dynamicConfig.Name
+		},
+		func(ctx context.Context, dynamicConfig statsig.DynamicConfigAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newDynamicConfigFromAPI(ctx, &dynamicConfig, nil)
+			return DynamicConfigResourceModel{DynamicConfig: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
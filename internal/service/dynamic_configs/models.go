@@ -0,0 +1,141 @@
+package dynamic_configs
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/gates"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// DynamicConfigResourceModel describes the resource data model.
+type DynamicConfigResourceModel struct {
+	DynamicConfig
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DynamicConfig struct {
+	ID           types.String         `tfsdk:"id"`
+	Name         types.String         `tfsdk:"name"`
+	Description  types.String         `tfsdk:"description"`
+	IsEnabled    types.Bool           `tfsdk:"is_enabled"`
+	IDType       types.String         `tfsdk:"id_type"`
+	Tags         types.Set            `tfsdk:"tags"`
+	TargetApps   types.Set            `tfsdk:"target_apps"`
+	DefaultValue jsontypes.Normalized `tfsdk:"default_value"`
+	Rules        []DynamicConfigRule  `tfsdk:"rule"`
+}
+
+// DynamicConfigRule extends the gate rule with the value returned to the users that pass the rule.
+type DynamicConfigRule struct {
+	gates.Rule
+	ReturnValue jsontypes.Normalized `tfsdk:"return_value"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+//
+// The JSON values have already been validated by the schema, so they are sent as-is. Statsig normalizes
+// them, and the semantic equality of the normalized type prevents key order or whitespace from showing a diff.
+func (c *DynamicConfig) toAPIRequest(ctx context.Context) (statsig.DynamicConfigAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.DynamicConfigAPIRequest{
+		ID:           c.ID.ValueString(),
+		Name:         c.Name.ValueString(),
+		Description:  c.Description.ValueString(),
+		IsEnabled:    c.IsEnabled.ValueBool(),
+		IDType:       c.IDType.ValueString(),
+		DefaultValue: json.RawMessage(c.DefaultValue.ValueString()),
+	}
+
+	tags, d := common.StringSetElements(ctx, c.Tags)
+	diags.Append(d...)
+	apiReq.Tags = tags
+	targetApps, d := common.StringSetElements(ctx, c.TargetApps)
+	diags.Append(d...)
+	apiReq.TargetApps = targetApps
+
+	rules := make([]gates.Rule, 0, len(c.Rules))
+	for _, rule := range c.Rules {
+		rules = append(rules, rule.Rule)
+	}
+	apiRules, d := gates.RulesToAPI(ctx, rules)
+	diags.Append(d...)
+
+	apiReq.Rules = make([]statsig.DynamicConfigRuleAPIRequest, 0, len(apiRules))
+	for i, apiRule := range apiRules {
+		apiReq.Rules = append(apiReq.Rules, statsig.DynamicConfigRuleAPIRequest{
+			ID:             apiRule.ID,
+			Name:           apiRule.Name,
+			PassPercentage: apiRule.PassPercentage,
+			Conditions:     apiRule.Conditions,
+			Environments:   apiRule.Environments,
+			ReturnValue:    json.RawMessage(c.Rules[i].ReturnValue.ValueString()),
+		})
+	}
+
+	return apiReq, diags
+}
+
+// newDynamicConfigFromAPI maps the API response model to the Terraform model. prior holds the rules of the plan or
+// state the dynamic config is read into, which decide how rules without environments are read.
+func newDynamicConfigFromAPI(ctx context.Context, dynamicConfig *statsig.DynamicConfigAPIRequest, prior []DynamicConfigRule) (DynamicConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := common.StringSetValue(ctx, dynamicConfig.Tags)
+	diags.Append(d...)
+	targetApps, d := common.StringSetValue(ctx, dynamicConfig.TargetApps)
+	diags.Append(d...)
+
+	apiRules := make([]statsig.RuleAPIRequest, 0, len(dynamicConfig.Rules))
+	for _, apiRule := range dynamicConfig.Rules {
+		apiRules = append(apiRules, statsig.RuleAPIRequest{
+			ID:             apiRule.ID,
+			Name:           apiRule.Name,
+			PassPercentage: apiRule.PassPercentage,
+			Conditions:     apiRule.Conditions,
+			Environments:   apiRule.Environments,
+		})
+	}
+	priorRules := make([]gates.Rule, 0, len(prior))
+	for _, rule := range prior {
+		priorRules = append(priorRules, rule.Rule)
+	}
+	rules, d := gates.RulesFromAPI(ctx, apiRules, priorRules)
+	diags.Append(d...)
+
+	var configRules []DynamicConfigRule
+	for i, rule := range rules {
+		configRules = append(configRules, DynamicConfigRule{
+			Rule:        rule,
+			ReturnValue: jsonValue(dynamicConfig.Rules[i].ReturnValue),
+		})
+	}
+
+	return DynamicConfig{
+		ID:           types.StringValue(dynamicConfig.ID),
+		Name:         types.StringValue(dynamicConfig.Name),
+		Description:  types.StringValue(dynamicConfig.Description),
+		IsEnabled:    types.BoolValue(dynamicConfig.IsEnabled),
+		IDType:       types.StringValue(dynamicConfig.IDType),
+		Tags:         tags,
+		TargetApps:   targetApps,
+		DefaultValue: jsonValue(dynamicConfig.DefaultValue),
+		Rules:        configRules,
+	}, diags
+}
+
+// jsonValue converts a raw JSON value returned by the API into a normalized JSON string. A missing value
+// is read as an empty object, which is what Statsig evaluates it as.
+func jsonValue(raw json.RawMessage) jsontypes.Normalized {
+	if len(raw) == 0 || string(raw) == "null" {
+		return jsontypes.NewNormalizedValue("{}")
+	}
+
+	return jsontypes.NewNormalizedValue(string(raw))
+}
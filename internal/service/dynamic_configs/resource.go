@@ -0,0 +1,363 @@
+package dynamic_configs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/gates"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DynamicConfigResource{}
+	_ resource.ResourceWithImportState    = &DynamicConfigResource{}
+	_ resource.ResourceWithIdentity       = &DynamicConfigResource{}
+	_ resource.ResourceWithConfigure      = &DynamicConfigResource{}
+	_ resource.ResourceWithValidateConfig = &DynamicConfigResource{}
+)
+
+func NewDynamicConfigResource() resource.Resource {
+	return &DynamicConfigResource{}
+}
+
+type DynamicConfigResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *DynamicConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dynamic_config"
+}
+
+func (r *DynamicConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a dynamic config in the Statsig Project.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the dynamic config ID from the name when it is created, so a dynamic config cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the dynamic config. Changing the name forces a new dynamic config to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the dynamic config",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the dynamic config is enabled. A disabled dynamic config returns the default value to every user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type the dynamic config is evaluated against, such as `userID` or `stableID`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("userID"),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The names of the tags applied to the dynamic config",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"target_apps": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the target apps the dynamic config is evaluated in",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"default_value": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded object returned to users that do not pass any rule. " +
+					"Differences in key order or whitespace are not considered changes.",
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				Validators: []validator.String{
+					common.JSONObject(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the dynamic config",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "The rules of the dynamic config, in evaluation order. " +
+					"The first rule that matches a user decides the value returned to them.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: ruleAttributes(),
+					Blocks: map[string]schema.Block{
+						"condition": gates.ConditionBlock(),
+					},
+				},
+			},
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DynamicConfigResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *DynamicConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures the rule names are unique, as the rule IDs assigned by Statsig are tracked by name.
+func (r *DynamicConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules types.List
+
+	// The rules are unknown when they are generated by a dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() || rules.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(gates.ValidateUniqueRuleNames(path.Root("rule"), gates.RuleNames(rules))...)
+}
+
+// ruleAttributes extends the gate rule attributes with the JSON value returned by the rule.
+func ruleAttributes() map[string]schema.Attribute {
+	attributes := gates.RuleAttributes()
+	attributes["return_value"] = schema.StringAttribute{
+		MarkdownDescription: "The JSON-encoded object returned to users that pass the rule",
+		CustomType:          jsontypes.NormalizedType{},
+		Required:            true,
+		Validators: []validator.String{
+			common.JSONObject(),
+		},
+	}
+
+	return attributes
+}
+
+// Create builds a new dynamic config with the provided attributes.
+//
+// Dynamic configs are referenced by their ID, which the API derives from the dynamic config name.
+func (r *DynamicConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DynamicConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the dynamic config
+	dynamicConfig, err := r.client.CreateDynamicConfig(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create dynamic config, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the dynamic config attributes
+	plan.DynamicConfig, diags = newDynamicConfigFromAPI(ctx, dynamicConfig, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config created with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Read fetches the dynamic config from the API and updates the Terraform state with the dynamic config attributes.
+func (r *DynamicConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DynamicConfigResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the dynamic config from the API
+	dynamicConfig, err := r.client.GetDynamicConfig(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Dynamic config %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the state with the dynamic config attributes
+	state.DynamicConfig, diags = newDynamicConfigFromAPI(ctx, dynamicConfig, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the dynamic config as specified in the Terraform plan.
+//
+// The ID of the dynamic config is immutable in the Statsig API, so changes to the name force a replacement instead.
+func (r *DynamicConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DynamicConfigResourceModel
+	var state DynamicConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the dynamic config
+	dynamicConfig, err := r.client.UpdateDynamicConfig(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Dynamic Config",
+			fmt.Sprintf("Unable to update dynamic config, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the dynamic config attributes
+	plan.DynamicConfig, diags = newDynamicConfigFromAPI(ctx, dynamicConfig, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *DynamicConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DynamicConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteDynamicConfig(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Dynamic Config",
+			"Unable to delete dynamic config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing dynamic config by its ID. The remaining attributes are populated by Read.
+func (r *DynamicConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,77 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ExperimentListResource{}
+	_ list.ListResourceWithConfigure = &ExperimentListResource{}
+)
+
+func NewExperimentListResource() list.ListResource {
+	return &ExperimentListResource{}
+}
+
+// ExperimentListResource lists the experiments of the Statsig Project, so they can be imported with `terraform query`.
+type ExperimentListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *ExperimentListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment"
+}
+
+func (r *ExperimentListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the experiments in the Statsig Project.",
+	}
+}
+
+func (r *ExperimentListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every experiment of the project, up to the limit of the query.
+func (r *ExperimentListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	experiments, err := r.client.GetExperiments(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, experiments,
+		func(experiment statsig.ExperimentAPIRequest) (string, string) {
+			return experiment.ID, experiment.Name
+		},
+		func(ctx context.Context, experiment statsig.ExperimentAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newExperimentFromAPI(ctx, &experiment)
+			return ExperimentResourceModel{Experiment: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
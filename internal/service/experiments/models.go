@@ -0,0 +1,220 @@
+package experiments
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// ExperimentResourceModel describes the resource data model.
+type ExperimentResourceModel struct {
+	Experiment
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Experiment struct {
+	ID                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	Description       types.String  `tfsdk:"description"`
+	Hypothesis        types.String  `tfsdk:"hypothesis"`
+	IDType            types.String  `tfsdk:"id_type"`
+	AllocationPercent types.Float64 `tfsdk:"allocation_percent"`
+	TargetingGateID   types.String  `tfsdk:"targeting_gate_id"`
+	LayerID           types.String  `tfsdk:"layer_id"`
+	PrimaryMetrics    []Metric      `tfsdk:"primary_metrics"`
+	SecondaryMetrics  []Metric      `tfsdk:"secondary_metrics"`
+	Tags              types.Set     `tfsdk:"tags"`
+	TargetApps        types.Set     `tfsdk:"target_apps"`
+	Status            types.String  `tfsdk:"status"`
+	DecisionGroup     types.String  `tfsdk:"decision_group"`
+	Groups            []Group       `tfsdk:"group"`
+}
+
+type Metric struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// metricAttrTypes are the attribute types of a Metric.
+var metricAttrTypes = map[string]attr.Type{
+	"name": types.StringType,
+	"type": types.StringType,
+}
+
+type Group struct {
+	Name            types.String         `tfsdk:"name"`
+	Size            types.Float64        `tfsdk:"size"`
+	ParameterValues jsontypes.Normalized `tfsdk:"parameter_values"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model. The status is not included, as it is
+// changed through the lifecycle endpoints rather than by updating the experiment.
+func (e *Experiment) toAPIRequest(ctx context.Context) (statsig.ExperimentAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The ID type and layer are sent even when empty, so that removing the layer of an experiment in
+	// setup clears it rather than leaving the field out of the request.
+	idType := e.IDType.ValueString()
+	layerID := e.LayerID.ValueString()
+	apiReq := statsig.ExperimentAPIRequest{
+		ID:               e.ID.ValueString(),
+		Name:             e.Name.ValueString(),
+		Description:      e.Description.ValueString(),
+		Hypothesis:       e.Hypothesis.ValueString(),
+		IDType:           &idType,
+		Allocation:       e.AllocationPercent.ValueFloat64(),
+		TargetingGateID:  e.TargetingGateID.ValueString(),
+		LayerID:          &layerID,
+		PrimaryMetrics:   metricsToAPI(e.PrimaryMetrics),
+		SecondaryMetrics: metricsToAPI(e.SecondaryMetrics),
+	}
+
+	tags, d := common.StringSetElements(ctx, e.Tags)
+	diags.Append(d...)
+	apiReq.Tags = tags
+	targetApps, d := common.StringSetElements(ctx, e.TargetApps)
+	diags.Append(d...)
+	apiReq.TargetApps = targetApps
+
+	for _, group := range e.Groups {
+		apiReq.Groups = append(apiReq.Groups, statsig.ExperimentGroupAPIRequest{
+			Name:            group.Name.ValueString(),
+			Size:            group.Size.ValueFloat64(),
+			ParameterValues: json.RawMessage(group.ParameterValues.ValueString()),
+		})
+	}
+
+	return apiReq, diags
+}
+
+// newExperimentFromAPI maps the API response model to the Terraform model.
+//
+// The API does not return the shipped group of a concluded experiment, so the decision group is left
+// for the caller to carry over from the plan or prior state.
+func newExperimentFromAPI(ctx context.Context, experiment *statsig.ExperimentAPIRequest) (Experiment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := common.StringSetValue(ctx, experiment.Tags)
+	diags.Append(d...)
+	targetApps, d := common.StringSetValue(ctx, experiment.TargetApps)
+	diags.Append(d...)
+
+	var idType, layerID string
+	if experiment.IDType != nil {
+		idType = *experiment.IDType
+	}
+	if experiment.LayerID != nil {
+		layerID = *experiment.LayerID
+	}
+
+	var groups []Group
+	for _, group := range experiment.Groups {
+		parameterValues := jsontypes.NewNormalizedValue("{}")
+		if len(group.ParameterValues) > 0 && string(group.ParameterValues) != "null" {
+			parameterValues = jsontypes.NewNormalizedValue(string(group.ParameterValues))
+		}
+
+		groups = append(groups, Group{
+			Name:            types.StringValue(group.Name),
+			Size:            types.Float64Value(group.Size),
+			ParameterValues: parameterValues,
+		})
+	}
+
+	return Experiment{
+		ID:                types.StringValue(experiment.ID),
+		Name:              types.StringValue(experiment.Name),
+		Description:       types.StringValue(experiment.Description),
+		Hypothesis:        types.StringValue(experiment.Hypothesis),
+		IDType:            types.StringValue(idType),
+		AllocationPercent: types.Float64Value(experiment.Allocation),
+		TargetingGateID:   common.StringValueOrNull(experiment.TargetingGateID),
+		LayerID:           common.StringValueOrNull(layerID),
+		PrimaryMetrics:    metricsFromAPI(experiment.PrimaryMetrics),
+		SecondaryMetrics:  metricsFromAPI(experiment.SecondaryMetrics),
+		Tags:              tags,
+		TargetApps:        targetApps,
+		Status:            types.StringValue(experiment.Status),
+		DecisionGroup:     types.StringNull(),
+		Groups:            groups,
+	}, diags
+}
+
+func metricsToAPI(metrics []Metric) []statsig.ExperimentMetricAPIRequest {
+	apiMetrics := make([]statsig.ExperimentMetricAPIRequest, 0, len(metrics))
+	for _, metric := range metrics {
+		apiMetrics = append(apiMetrics, statsig.ExperimentMetricAPIRequest{
+			Name: metric.Name.ValueString(),
+			Type: metric.Type.ValueString(),
+		})
+	}
+
+	return apiMetrics
+}
+
+// metricsFromAPI maps the metrics returned by the API. Missing metrics are read as an empty list, which
+// is the default of the metric attributes.
+func metricsFromAPI(apiMetrics []statsig.ExperimentMetricAPIRequest) []Metric {
+	metrics := []Metric{}
+	for _, metric := range apiMetrics {
+		metrics = append(metrics, Metric{
+			Name: types.StringValue(metric.Name),
+			Type: types.StringValue(metric.Type),
+		})
+	}
+
+	return metrics
+}
+
+// groupsEqual reports whether the groups are the same, comparing the parameter values semantically so
+// that differences in key order or whitespace are ignored. The groups are read as lists, as they are unknown
+// when they are generated by a dynamic block over an unknown value. Unknown values are not compared.
+func groupsEqual(ctx context.Context, a, b types.List) (bool, diag.Diagnostics) {
+	if a.IsUnknown() || b.IsUnknown() {
+		return true, nil
+	}
+	if len(a.Elements()) != len(b.Elements()) {
+		return false, nil
+	}
+
+	for i := range a.Elements() {
+		aElement, bElement := a.Elements()[i], b.Elements()[i]
+		if aElement.IsUnknown() || bElement.IsUnknown() {
+			continue
+		}
+
+		var aGroup, bGroup Group
+		diags := aElement.(types.Object).As(ctx, &aGroup, basetypes.ObjectAsOptions{})
+		diags.Append(bElement.(types.Object).As(ctx, &bGroup, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if !knownEqual(aGroup.Name, bGroup.Name) || !knownEqual(aGroup.Size, bGroup.Size) {
+			return false, nil
+		}
+		if aGroup.ParameterValues.IsUnknown() || bGroup.ParameterValues.IsUnknown() {
+			continue
+		}
+
+		equal, diags := aGroup.ParameterValues.StringSemanticEquals(ctx, bGroup.ParameterValues)
+		if diags.HasError() || !equal {
+			return false, diags
+		}
+	}
+
+	return true, nil
+}
+
+// knownEqual reports whether two values are equal, considering an unknown value equal to any other.
+func knownEqual(a, b attr.Value) bool {
+	return a.IsUnknown() || b.IsUnknown() || a.Equal(b)
+}
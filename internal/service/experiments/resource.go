@@ -0,0 +1,655 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ExperimentResource{}
+	_ resource.ResourceWithImportState    = &ExperimentResource{}
+	_ resource.ResourceWithIdentity       = &ExperimentResource{}
+	_ resource.ResourceWithConfigure      = &ExperimentResource{}
+	_ resource.ResourceWithValidateConfig = &ExperimentResource{}
+	_ resource.ResourceWithModifyPlan     = &ExperimentResource{}
+)
+
+// experimentStatuses are the statuses in lifecycle order. An experiment can only move forward.
+var experimentStatuses = []string{
+	statsig.ExperimentStatusSetup,
+	statsig.ExperimentStatusActive,
+	statsig.ExperimentStatusDecisionMade,
+	statsig.ExperimentStatusAbandoned,
+}
+
+func NewExperimentResource() resource.Resource {
+	return &ExperimentResource{}
+}
+
+type ExperimentResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *ExperimentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_experiment"
+}
+
+func (r *ExperimentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	metricAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the metric",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the metric, such as `event_count` or `ratio`",
+			Required:            true,
+		},
+	}
+
+	// The metrics default to an empty list rather than null, as the API does not distinguish between them.
+	noMetrics := types.ListValueMust(types.ObjectType{AttrTypes: metricAttrTypes}, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create an experiment in the Statsig Project, and manage its lifecycle with the `status` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the experiment ID from the name when it is created, so an experiment cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the experiment. Changing the name forces a new experiment to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the experiment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"hypothesis": schema.StringAttribute{
+				MarkdownDescription: "The hypothesis the experiment is testing",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type users are allocated by, such as `userID` or `stableID`. " +
+					"Cannot be changed once the experiment has started.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("userID"),
+			},
+			"allocation_percent": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of eligible users allocated to the experiment, between 0 and 100",
+				Optional:            true,
+				Computed:            true,
+				Default:             float64default.StaticFloat64(100),
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"targeting_gate_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the gate users must pass to be allocated to the experiment",
+				Optional:            true,
+			},
+			"layer_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the `statsig_layer` the experiment belongs to. The parameter values of the groups " +
+					"override the parameters of the layer. Cannot be changed once the experiment has started.",
+				Optional: true,
+			},
+			"primary_metrics": schema.ListNestedAttribute{
+				MarkdownDescription: "The metrics the experiment is expected to move",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(noMetrics),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: metricAttributes,
+				},
+			},
+			"secondary_metrics": schema.ListNestedAttribute{
+				MarkdownDescription: "Additional metrics monitored by the experiment",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(noMetrics),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: metricAttributes,
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The names of the tags applied to the experiment",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"target_apps": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the target apps the experiment is evaluated in",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			// The status is computed when not configured, so experiments started from the console are not
+			// moved back by Terraform.
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The lifecycle status of the experiment: `setup`, `active`, `decision_made` or `abandoned`. " +
+					"Changing the status starts, concludes or abandons the experiment. An experiment can only move forward.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(experimentStatuses...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"decision_group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group to ship when the status is `decision_made`",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the experiment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"group": schema.ListNestedBlock{
+				MarkdownDescription: "The groups users are allocated to. The group sizes must add up to 100. " +
+					"Cannot be changed once the experiment has started.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group",
+							Required:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "The percentage of allocated users assigned to the group",
+							Required:            true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						"parameter_values": schema.StringAttribute{
+							MarkdownDescription: "The JSON-encoded object of parameter values returned to users in the group",
+							CustomType:          jsontypes.NormalizedType{},
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("{}"),
+							Validators: []validator.String{
+								common.JSONObject(),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ExperimentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *ExperimentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the group sizes and the decision group, which depend on more than one attribute.
+func (r *ExperimentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var groupList types.List
+	var status types.String
+	var decisionGroup types.String
+
+	// The groups are read as a list rather than decoded, as they are unknown when they are generated by a
+	// dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("group"), &groupList)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &status)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("decision_group"), &decisionGroup)...)
+	if resp.Diagnostics.HasError() || groupList.IsUnknown() {
+		return
+	}
+
+	var groups []Group
+	resp.Diagnostics.Append(groupList.ElementsAs(ctx, &groups, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	total := 0.0
+	groupNames := []string{}
+	for _, group := range groups {
+		if group.Size.IsUnknown() || group.Name.IsUnknown() {
+			return
+		}
+		total += group.Size.ValueFloat64()
+		groupNames = append(groupNames, group.Name.ValueString())
+	}
+
+	if len(groups) > 0 && total != 100 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group"),
+			"Invalid Group Sizes",
+			fmt.Sprintf("The sizes of the experiment groups must add up to 100, got: %g", total),
+		)
+	}
+
+	if status.IsUnknown() || decisionGroup.IsUnknown() {
+		return
+	}
+
+	if status.ValueString() == statsig.ExperimentStatusDecisionMade && decisionGroup.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("decision_group"),
+			"Missing Decision Group",
+			"The decision_group attribute must be set to the name of the group to ship when the status is \"decision_made\".",
+		)
+	}
+
+	if !decisionGroup.IsNull() && !slices.Contains(groupNames, decisionGroup.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("decision_group"),
+			"Unknown Decision Group",
+			fmt.Sprintf("The decision group %q is not one of the experiment groups.", decisionGroup.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan rejects changes that the API would refuse during apply: moving the status backwards, and
+// changing the attributes that are locked once the experiment has started.
+func (r *ExperimentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is locked when the experiment is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planStatus, planIDType, planLayerID types.String
+	var planGroups, stateGroups types.List
+	var state ExperimentResourceModel
+
+	// The planned attributes are read one by one rather than decoded, as the groups are unknown when they are
+	// generated by a dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("status"), &planStatus)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id_type"), &planIDType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("layer_id"), &planLayerID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("group"), &planGroups)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("group"), &stateGroups)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := state.Status.ValueString()
+	if !planStatus.IsUnknown() && !validTransition(status, planStatus.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid Experiment Status Change",
+			fmt.Sprintf("The experiment cannot move from %q to %q. An experiment can only move forward in its lifecycle, "+
+				"and cannot be changed once a decision is made or it is abandoned.", status, planStatus.ValueString()),
+		)
+	}
+
+	if status == statsig.ExperimentStatusSetup || status == "" {
+		return
+	}
+
+	if !planIDType.IsUnknown() && !planIDType.Equal(state.IDType) {
+		resp.Diagnostics.Append(lockedAttributeError(path.Root("id_type"), status))
+	}
+
+	if !planLayerID.IsUnknown() && !planLayerID.Equal(state.LayerID) {
+		resp.Diagnostics.Append(lockedAttributeError(path.Root("layer_id"), status))
+	}
+
+	equal, diags := groupsEqual(ctx, planGroups, stateGroups)
+	resp.Diagnostics.Append(diags...)
+	if !equal {
+		resp.Diagnostics.Append(lockedAttributeError(path.Root("group"), status))
+	}
+}
+
+// validTransition reports whether an experiment can move from one status to another. Concluded
+// experiments cannot change status.
+func validTransition(from string, to string) bool {
+	switch from {
+	case to, "":
+		return true
+	case statsig.ExperimentStatusDecisionMade, statsig.ExperimentStatusAbandoned:
+		return false
+	default:
+		return slices.Index(experimentStatuses, to) > slices.Index(experimentStatuses, from)
+	}
+}
+
+func lockedAttributeError(attributePath path.Path, status string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attributePath,
+		"Attribute Locked After Experiment Start",
+		fmt.Sprintf("The %s of an experiment cannot be changed once it has started, and this experiment is %q. "+
+			"Revert the change, or replace the experiment to run it with the new configuration.", attributePath, status),
+	)
+}
+
+// Create builds a new experiment with the provided attributes, and then moves it to the planned status.
+func (r *ExperimentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ExperimentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the experiment
+	experiment, err := r.client.CreateExperiment(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create experiment, got error: %s", err),
+		)
+		return
+	}
+
+	// Save the experiment into the state before changing its status, so a failed transition does not
+	// leave an untracked experiment behind.
+	state := ExperimentResourceModel{Timeouts: plan.Timeouts}
+	state.Experiment, diags = newExperimentFromAPI(ctx, experiment)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment created with Name: %s; and ID: %s", state.Name, state.ID))
+
+	resp.Diagnostics.Append(r.transition(ctx, experiment.ID, experiment.Status, plan.Experiment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Experiment, diags = r.read(ctx, experiment.ID, plan.DecisionGroup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Read fetches the experiment from the API and updates the Terraform state with the experiment attributes.
+func (r *ExperimentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ExperimentResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the experiment from the API
+	experiment, err := r.client.GetExperiment(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Experiment %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// The decision group is not returned by the API, so it is carried over from the state.
+	decisionGroup := state.DecisionGroup
+	state.Experiment, diags = newExperimentFromAPI(ctx, experiment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.DecisionGroup = decisionGroup
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the experiment as specified in the Terraform plan, and then moves it
+// to the planned status.
+//
+// The locked attributes are left out of the update once the experiment has started. ModifyPlan already
+// ensures they are unchanged, and the API rejects any update that includes them.
+func (r *ExperimentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ExperimentResourceModel
+	var state ExperimentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() != statsig.ExperimentStatusSetup {
+		apiReq.IDType = nil
+		apiReq.LayerID = nil
+		apiReq.Groups = nil
+	}
+
+	// Update the experiment
+	_, err := r.client.UpdateExperiment(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Experiment",
+			fmt.Sprintf("Unable to update experiment, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.transition(ctx, state.ID.ValueString(), state.Status.ValueString(), plan.Experiment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Experiment, diags = r.read(ctx, state.ID.ValueString(), plan.DecisionGroup)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *ExperimentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExperimentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteExperiment(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Experiment",
+			"Unable to delete experiment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing experiment by its ID. The remaining attributes are populated by Read.
+func (r *ExperimentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// read fetches the experiment from the API. The decision group is not returned by the API, so the
+// provided value is carried over.
+func (r *ExperimentResource) read(ctx context.Context, experimentID string, decisionGroup types.String) (Experiment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	experiment, err := r.client.GetExperiment(ctx, experimentID)
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return Experiment{}, diags
+	}
+
+	state, d := newExperimentFromAPI(ctx, experiment)
+	diags.Append(d...)
+	state.DecisionGroup = decisionGroup
+
+	return state, diags
+}
+
+// transition moves the experiment from its current status to the planned status through the lifecycle
+// endpoints. A decision can only be made on an active experiment, so an experiment in setup is started first.
+func (r *ExperimentResource) transition(ctx context.Context, experimentID string, current string, plan Experiment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	target := plan.Status.ValueString()
+	if plan.Status.IsUnknown() || plan.Status.IsNull() || target == current {
+		return diags
+	}
+
+	if current == statsig.ExperimentStatusSetup && target != statsig.ExperimentStatusAbandoned {
+		if err := r.client.StartExperiment(ctx, experimentID); err != nil {
+			diags.AddError(
+				"Error Starting Experiment",
+				fmt.Sprintf("Unable to start experiment, got error: %s", err),
+			)
+			return diags
+		}
+	}
+
+	switch target {
+	case statsig.ExperimentStatusDecisionMade:
+		decision := statsig.ExperimentDecisionAPIRequest{ID: plan.DecisionGroup.ValueString()}
+		if err := r.client.MakeExperimentDecision(ctx, experimentID, decision); err != nil {
+			diags.AddError(
+				"Error Making Experiment Decision",
+				fmt.Sprintf("Unable to ship group %q of experiment, got error: %s", decision.ID, err),
+			)
+		}
+	case statsig.ExperimentStatusAbandoned:
+		if err := r.client.AbandonExperiment(ctx, experimentID); err != nil {
+			diags.AddError(
+				"Error Abandoning Experiment",
+				fmt.Sprintf("Unable to abandon experiment, got error: %s", err),
+			)
+		}
+	}
+
+	return diags
+}
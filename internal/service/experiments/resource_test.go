@@ -0,0 +1,138 @@
+package experiments
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestValidTransition(t *testing.T) {
+	testCases := []struct {
+		from     string
+		to       string
+		expected bool
+	}{
+		{from: "", to: statsig.ExperimentStatusActive, expected: true},
+		{from: statsig.ExperimentStatusSetup, to: statsig.ExperimentStatusSetup, expected: true},
+		{from: statsig.ExperimentStatusSetup, to: statsig.ExperimentStatusActive, expected: true},
+		{from: statsig.ExperimentStatusSetup, to: statsig.ExperimentStatusDecisionMade, expected: true},
+		{from: statsig.ExperimentStatusSetup, to: statsig.ExperimentStatusAbandoned, expected: true},
+		{from: statsig.ExperimentStatusActive, to: statsig.ExperimentStatusSetup, expected: false},
+		{from: statsig.ExperimentStatusActive, to: statsig.ExperimentStatusDecisionMade, expected: true},
+		{from: statsig.ExperimentStatusActive, to: statsig.ExperimentStatusAbandoned, expected: true},
+		{from: statsig.ExperimentStatusDecisionMade, to: statsig.ExperimentStatusDecisionMade, expected: true},
+		{from: statsig.ExperimentStatusDecisionMade, to: statsig.ExperimentStatusAbandoned, expected: false},
+		{from: statsig.ExperimentStatusAbandoned, to: statsig.ExperimentStatusActive, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := validTransition(testCase.from, testCase.to); got != testCase.expected {
+			t.Errorf("validTransition(%q, %q): expected %t, got %t", testCase.from, testCase.to, testCase.expected, got)
+		}
+	}
+}
+
+func TestExperimentResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &ExperimentResource{}
+	schemas := commontest.NewResource(t, r)
+	groupsType := schemas.Type().AttributeTypes["group"].(tftypes.List)
+	groupType := groupsType.ElementType.(tftypes.Object)
+
+	groupValue := func(name string, size any, parameterValues any) tftypes.Value {
+		return commontest.ObjectValue(groupType, map[string]tftypes.Value{
+			"name":             tftypes.NewValue(tftypes.String, name),
+			"size":             tftypes.NewValue(tftypes.Number, size),
+			"parameter_values": tftypes.NewValue(tftypes.String, parameterValues),
+		})
+	}
+	experimentValue := func(status string, groups tftypes.Value) tftypes.Value {
+		return schemas.Value(map[string]tftypes.Value{
+			"id":      tftypes.NewValue(tftypes.String, "pricing"),
+			"name":    tftypes.NewValue(tftypes.String, "pricing"),
+			"id_type": tftypes.NewValue(tftypes.String, "userID"),
+			"status":  tftypes.NewValue(tftypes.String, status),
+			"group":   groups,
+		})
+	}
+	stateGroups := tftypes.NewValue(groupsType, []tftypes.Value{
+		groupValue("control", 50, `{"price": 10}`),
+		groupValue("test", 50, `{"price": 12}`),
+	})
+
+	testCases := map[string]struct {
+		status   string
+		groups   tftypes.Value
+		expected []path.Path
+	}{
+		"unchanged": {
+			status: statsig.ExperimentStatusActive,
+			groups: tftypes.NewValue(groupsType, []tftypes.Value{
+				groupValue("control", 50, `{ "price": 10 }`),
+				groupValue("test", 50, `{"price": 12}`),
+			}),
+		},
+		"unknown groups": {
+			status: statsig.ExperimentStatusActive,
+			groups: tftypes.NewValue(groupsType, tftypes.UnknownValue),
+		},
+		"unknown group": {
+			status: statsig.ExperimentStatusActive,
+			groups: tftypes.NewValue(groupsType, []tftypes.Value{
+				groupValue("control", 50, `{"price": 10}`),
+				tftypes.NewValue(groupType, tftypes.UnknownValue),
+			}),
+		},
+		"unknown group values": {
+			status: statsig.ExperimentStatusActive,
+			groups: tftypes.NewValue(groupsType, []tftypes.Value{
+				groupValue("control", tftypes.UnknownValue, tftypes.UnknownValue),
+				groupValue("test", 50, `{"price": 12}`),
+			}),
+		},
+		"changed group after an unknown group": {
+			status: statsig.ExperimentStatusActive,
+			groups: tftypes.NewValue(groupsType, []tftypes.Value{
+				groupValue("control", 50, tftypes.UnknownValue),
+				groupValue("test", 50, `{"price": 15}`),
+			}),
+			expected: []path.Path{path.Root("group")},
+		},
+		"changed group in setup": {
+			status: statsig.ExperimentStatusSetup,
+			groups: tftypes.NewValue(groupsType, []tftypes.Value{
+				groupValue("control", 40, `{"price": 10}`),
+				groupValue("test", 60, `{"price": 12}`),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemas.Schema, Raw: experimentValue(testCase.status, stateGroups)},
+				Plan:  tfsdk.Plan{Schema: schemas.Schema, Raw: experimentValue(testCase.status, testCase.groups)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			var paths []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					paths = append(paths, withPath.Path())
+				}
+			}
+			if len(paths) != resp.Diagnostics.ErrorsCount() || !slices.EqualFunc(paths, testCase.expected, path.Path.Equal) {
+				t.Errorf("expected errors on %v, got: %v", testCase.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
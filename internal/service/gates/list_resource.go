@@ -0,0 +1,77 @@
+package gates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &GateListResource{}
+	_ list.ListResourceWithConfigure = &GateListResource{}
+)
+
+func NewGateListResource() list.ListResource {
+	return &GateListResource{}
+}
+
+// GateListResource lists the gates of the Statsig Project, so they can be imported with `terraform query`.
+type GateListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *GateListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gate"
+}
+
+func (r *GateListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the gates in the Statsig Project.",
+	}
+}
+
+func (r *GateListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every gate of the project, up to the limit of the query.
+func (r *GateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	gates, err := r.client.GetGates(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, gates,
+		func(gate statsig.GateAPIRequest) (string, string) {
+			return gate.ID, gate.Name
+		},
+		func(ctx context.Context, gate statsig.GateAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newGateFromAPI(ctx, &gate, nil)
+			return GateResourceModel{Gate: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
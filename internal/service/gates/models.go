@@ -0,0 +1,89 @@
+package gates
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// GateResourceModel describes the resource data model.
+type GateResourceModel struct {
+	Gate
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Gate struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
+	IDType      types.String `tfsdk:"id_type"`
+	Tags        types.Set    `tfsdk:"tags"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	TargetApps  types.Set    `tfsdk:"target_apps"`
+	Rules       []Rule       `tfsdk:"rule"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+func (g *Gate) toAPIRequest(ctx context.Context) (statsig.GateAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.GateAPIRequest{
+		ID:          g.ID.ValueString(),
+		Name:        g.Name.ValueString(),
+		Description: g.Description.ValueString(),
+		IsEnabled:   g.IsEnabled.ValueBool(),
+		IDType:      g.IDType.ValueString(),
+	}
+
+	tags, d := common.StringSetElements(ctx, g.Tags)
+	diags.Append(d...)
+	apiReq.Tags = tags
+	targetApps, d := common.StringSetElements(ctx, g.TargetApps)
+	diags.Append(d...)
+	apiReq.TargetApps = targetApps
+	rules, d := RulesToAPI(ctx, g.Rules)
+	diags.Append(d...)
+	apiReq.Rules = rules
+
+	// The owner defaults to the creator of the API key, so it is only sent when set explicitly.
+	if !g.OwnerID.IsNull() && !g.OwnerID.IsUnknown() {
+		apiReq.Owner = &statsig.GateOwner{OwnerID: g.OwnerID.ValueString()}
+	}
+
+	return apiReq, diags
+}
+
+// newGateFromAPI maps the API response model to the Terraform model. prior holds the rules of the plan or state
+// the gate is read into, which decide how rules without environments are read.
+func newGateFromAPI(ctx context.Context, gate *statsig.GateAPIRequest, prior []Rule) (Gate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := common.StringSetValue(ctx, gate.Tags)
+	diags.Append(d...)
+	targetApps, d := common.StringSetValue(ctx, gate.TargetApps)
+	diags.Append(d...)
+	rules, d := RulesFromAPI(ctx, gate.Rules, prior)
+	diags.Append(d...)
+
+	ownerID := types.StringNull()
+	if gate.Owner != nil {
+		ownerID = types.StringValue(gate.Owner.OwnerID)
+	}
+
+	return Gate{
+		ID:          types.StringValue(gate.ID),
+		Name:        types.StringValue(gate.Name),
+		Description: types.StringValue(gate.Description),
+		IsEnabled:   types.BoolValue(gate.IsEnabled),
+		IDType:      types.StringValue(gate.IDType),
+		Tags:        tags,
+		OwnerID:     ownerID,
+		TargetApps:  targetApps,
+		Rules:       rules,
+	}, diags
+}
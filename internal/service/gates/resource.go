@@ -0,0 +1,335 @@
+package gates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &GateResource{}
+	_ resource.ResourceWithImportState    = &GateResource{}
+	_ resource.ResourceWithIdentity       = &GateResource{}
+	_ resource.ResourceWithConfigure      = &GateResource{}
+	_ resource.ResourceWithValidateConfig = &GateResource{}
+)
+
+func NewGateResource() resource.Resource {
+	return &GateResource{}
+}
+
+type GateResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *GateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gate"
+}
+
+func (r *GateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a feature gate in the Statsig Project.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the gate ID from the name when the gate is created, so a gate cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the gate. Changing the name forces a new gate to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the gate",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the gate is enabled. A disabled gate fails for every user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type the gate is evaluated against, such as `userID` or `stableID`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("userID"),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The names of the tags applied to the gate",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Statsig user that owns the gate. Defaults to the owner of the Console API key.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_apps": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the target apps the gate is evaluated in",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the gate",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"rule":     RuleBlock(),
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *GateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *GateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures the rule names are unique, as the rule IDs assigned by Statsig are tracked by name.
+func (r *GateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules types.List
+
+	// The rules are unknown when they are generated by a dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() || rules.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateUniqueRuleNames(path.Root("rule"), RuleNames(rules))...)
+}
+
+// Create builds a new gate with the provided attributes.
+//
+// Unlike tags, gates are referenced by their ID, which the API derives from the gate name.
+func (r *GateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the gate
+	gate, err := r.client.CreateGate(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create gate, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the gate attributes
+	plan.Gate, diags = newGateFromAPI(ctx, gate, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate created with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Read fetches the gate from the API and updates the Terraform state with the gate attributes.
+func (r *GateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GateResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the gate from the API
+	gate, err := r.client.GetGate(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Gate %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the state with the gate attributes
+	state.Gate, diags = newGateFromAPI(ctx, gate, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the gate as specified in the Terraform plan.
+//
+// The ID of the gate is immutable in the Statsig API, so changes to the name force a replacement instead.
+func (r *GateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GateResourceModel
+	var state GateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the gate
+	gate, err := r.client.UpdateGate(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Gate",
+			fmt.Sprintf("Unable to update gate, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the gate attributes
+	plan.Gate, diags = newGateFromAPI(ctx, gate, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *GateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteGate(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Gate",
+			"Unable to delete gate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing gate by its ID. The remaining attributes are populated by Read.
+func (r *GateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,397 @@
+package gates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// ConditionTypes are the condition types supported by the Statsig Console API.
+var ConditionTypes = []string{
+	"public",
+	"user_id",
+	"email",
+	"ip_based_country",
+	"country",
+	"app_version",
+	"browser_name",
+	"browser_version",
+	"os_name",
+	"os_version",
+	"device_model",
+	"locale",
+	"custom_field",
+	"unit_id",
+	"environment_tier",
+	"passes_gate",
+	"fails_gate",
+	"passes_segment",
+	"fails_segment",
+	"target_app",
+	"time",
+}
+
+// ConditionOperators are the condition operators supported by the Statsig Console API.
+var ConditionOperators = []string{
+	"any",
+	"none",
+	"any_case_sensitive",
+	"none_case_sensitive",
+	"str_contains_any",
+	"str_contains_none",
+	"str_matches",
+	"gt",
+	"gte",
+	"lt",
+	"lte",
+	"eq",
+	"neq",
+	"version_gt",
+	"version_gte",
+	"version_lt",
+	"version_lte",
+	"version_eq",
+	"version_neq",
+	"before",
+	"after",
+	"on",
+	"is_null",
+	"is_not_null",
+	"in_segment_list",
+	"not_in_segment_list",
+	"array_contains_any",
+	"array_contains_none",
+	"array_contains_all",
+	"not_array_contains_all",
+}
+
+type Rule struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	PassPercentage types.Float64 `tfsdk:"pass_percentage"`
+	Environments   types.Set     `tfsdk:"environments"`
+	Conditions     []Condition   `tfsdk:"condition"`
+}
+
+type Condition struct {
+	Type        types.String `tfsdk:"type"`
+	Operator    types.String `tfsdk:"operator"`
+	TargetValue types.List   `tfsdk:"target_value"`
+	Field       types.String `tfsdk:"field"`
+	CustomID    types.String `tfsdk:"custom_id"`
+}
+
+// RuleBlock returns the schema of the ordered `rule` blocks of a gate.
+//
+// The order of the blocks is the evaluation order of the rules, so reordering them is a real change.
+func RuleBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "The rules of the gate, in evaluation order. The first rule that matches a user decides the result.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: RuleAttributes(),
+			Blocks: map[string]schema.Block{
+				"condition": ConditionBlock(),
+			},
+		},
+	}
+}
+
+// RuleAttributes returns the attributes shared by every rule block, so entities with additional rule
+// attributes can extend them.
+func RuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the rule, assigned by Statsig",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				RuleIDFromState(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the rule. Rule names must be unique within the entity.",
+			Required:            true,
+		},
+		"pass_percentage": schema.Float64Attribute{
+			MarkdownDescription: "The percentage of users matching the rule that pass it, between 0 and 100",
+			Required:            true,
+			Validators: []validator.Float64{
+				float64validator.Between(0, 100),
+			},
+		},
+		"environments": schema.SetAttribute{
+			MarkdownDescription: "The environments the rule is evaluated in. The rule applies to every environment when unset.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
+
+// ConditionBlock returns the schema of the `condition` blocks of a rule. A user must match every condition
+// of a rule for the rule to apply.
+func ConditionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "The conditions of the rule. A user must match every condition for the rule to apply.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the condition, such as `public`, `email` or `custom_field`",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(ConditionTypes...),
+					},
+				},
+				"operator": schema.StringAttribute{
+					MarkdownDescription: "The operator used to compare the user against the target value, such as `any` or `gt`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(ConditionOperators...),
+					},
+				},
+				"target_value": schema.ListAttribute{
+					MarkdownDescription: "The values the condition compares against",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+				"field": schema.StringAttribute{
+					MarkdownDescription: "The user field to compare for `custom_field` conditions",
+					Optional:            true,
+				},
+				"custom_id": schema.StringAttribute{
+					MarkdownDescription: "The custom ID type to compare for `unit_id` conditions",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// RulesToAPI maps the Terraform rule blocks to the API request model.
+func RulesToAPI(ctx context.Context, rules []Rule) ([]statsig.RuleAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiRules := make([]statsig.RuleAPIRequest, 0, len(rules))
+	for _, rule := range rules {
+		apiRule := statsig.RuleAPIRequest{
+			ID:             rule.ID.ValueString(),
+			Name:           rule.Name.ValueString(),
+			PassPercentage: rule.PassPercentage.ValueFloat64(),
+		}
+
+		if !rule.Environments.IsNull() && !rule.Environments.IsUnknown() {
+			diags.Append(rule.Environments.ElementsAs(ctx, &apiRule.Environments, false)...)
+		}
+
+		conditions, d := ConditionsToAPI(ctx, rule.Conditions)
+		diags.Append(d...)
+		apiRule.Conditions = conditions
+
+		apiRules = append(apiRules, apiRule)
+	}
+
+	return apiRules, diags
+}
+
+// RulesFromAPI maps the rules returned by the API to the Terraform rule blocks.
+//
+// The API omits the environments of a rule that applies to every environment, which is read as null. An empty
+// set of environments is kept instead when the rule with the same name in prior has one, so configuring
+// `environments = []` does not produce a diff.
+func RulesFromAPI(ctx context.Context, apiRules []statsig.RuleAPIRequest, prior []Rule) ([]Rule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorEnvironments := map[string]types.Set{}
+	for _, rule := range prior {
+		priorEnvironments[rule.Name.ValueString()] = rule.Environments
+	}
+
+	var rules []Rule
+	for _, apiRule := range apiRules {
+		environments := types.SetNull(types.StringType)
+		if previous, ok := priorEnvironments[apiRule.Name]; ok && !previous.IsNull() && !previous.IsUnknown() {
+			environments = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+		if len(apiRule.Environments) > 0 {
+			var d diag.Diagnostics
+			environments, d = types.SetValueFrom(ctx, types.StringType, apiRule.Environments)
+			diags.Append(d...)
+		}
+
+		conditions, d := ConditionsFromAPI(ctx, apiRule.Conditions)
+		diags.Append(d...)
+
+		rules = append(rules, Rule{
+			ID:             types.StringValue(apiRule.ID),
+			Name:           types.StringValue(apiRule.Name),
+			PassPercentage: types.Float64Value(apiRule.PassPercentage),
+			Environments:   environments,
+			Conditions:     conditions,
+		})
+	}
+
+	return rules, diags
+}
+
+// ConditionsToAPI maps the Terraform condition blocks to the API request model.
+func ConditionsToAPI(ctx context.Context, conditions []Condition) ([]statsig.ConditionAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiConditions := make([]statsig.ConditionAPIRequest, 0, len(conditions))
+	for _, condition := range conditions {
+		apiCondition := statsig.ConditionAPIRequest{
+			Type:     condition.Type.ValueString(),
+			Operator: condition.Operator.ValueString(),
+			Field:    condition.Field.ValueString(),
+			CustomID: condition.CustomID.ValueString(),
+		}
+
+		if !condition.TargetValue.IsNull() && !condition.TargetValue.IsUnknown() {
+			diags.Append(condition.TargetValue.ElementsAs(ctx, &apiCondition.TargetValue, false)...)
+		}
+
+		apiConditions = append(apiConditions, apiCondition)
+	}
+
+	return apiConditions, diags
+}
+
+// ConditionsFromAPI maps the conditions returned by the API to the Terraform condition blocks. Empty
+// values are read as null, as that is how they are represented when omitted from the configuration.
+func ConditionsFromAPI(ctx context.Context, apiConditions []statsig.ConditionAPIRequest) ([]Condition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var conditions []Condition
+	for _, apiCondition := range apiConditions {
+		targetValue := types.ListNull(types.StringType)
+		if apiCondition.TargetValue != nil {
+			var d diag.Diagnostics
+			targetValue, d = types.ListValueFrom(ctx, types.StringType, []string(apiCondition.TargetValue))
+			diags.Append(d...)
+		}
+
+		conditions = append(conditions, Condition{
+			Type:        types.StringValue(apiCondition.Type),
+			Operator:    common.StringValueOrNull(apiCondition.Operator),
+			TargetValue: targetValue,
+			Field:       common.StringValueOrNull(apiCondition.Field),
+			CustomID:    common.StringValueOrNull(apiCondition.CustomID),
+		})
+	}
+
+	return conditions, diags
+}
+
+// RuleIDFromState returns a plan modifier that carries the ID of an existing rule over to the plan.
+//
+// Rules are matched by name rather than by position, so inserting or reordering rules does not shift
+// the server-assigned IDs onto the wrong rule, and unchanged rules never show a pending ID change.
+func RuleIDFromState() planmodifier.String {
+	return ruleIDFromStateModifier{}
+}
+
+type ruleIDFromStateModifier struct{}
+
+func (m ruleIDFromStateModifier) Description(_ context.Context) string {
+	return "Uses the ID of the rule with the same name in the prior state."
+}
+
+func (m ruleIDFromStateModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m ruleIDFromStateModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to carry over when creating the resource, or when the ID is already known.
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// The ID path is <rules>[index].id, so the rule is the parent and the rule list its grandparent.
+	rulePath := req.Path.ParentPath()
+	rulesPath := rulePath.ParentPath()
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, rulePath.AtName("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() || name.IsNull() {
+		return
+	}
+
+	var stateRules types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, rulesPath, &stateRules)...)
+	if resp.Diagnostics.HasError() || stateRules.IsNull() || stateRules.IsUnknown() {
+		return
+	}
+
+	for _, element := range stateRules.Elements() {
+		stateRule, ok := element.(basetypes.ObjectValue)
+		if !ok {
+			continue
+		}
+
+		attributes := stateRule.Attributes()
+		if stateName, ok := attributes["name"].(types.String); !ok || !stateName.Equal(name) {
+			continue
+		}
+
+		if stateID, ok := attributes["id"].(types.String); ok && !stateID.IsNull() {
+			resp.PlanValue = stateID
+		}
+		return
+	}
+}
+
+// RuleNames returns the names of the rule blocks in a configuration, in order. Only the names are read, as the
+// conditions of a rule may be generated by a dynamic block over an unknown value, and the name of a rule that is
+// unknown as a whole is unknown.
+func RuleNames(rules types.List) []types.String {
+	names := make([]types.String, 0, len(rules.Elements()))
+	for _, element := range rules.Elements() {
+		name := types.StringUnknown()
+		if rule, ok := element.(basetypes.ObjectValue); ok && !rule.IsUnknown() && !rule.IsNull() {
+			if value, ok := rule.Attributes()["name"].(types.String); ok {
+				name = value
+			}
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// ValidateUniqueRuleNames reports an error for every rule that reuses the name of an earlier rule, as rule
+// IDs are matched to rules by name.
+func ValidateUniqueRuleNames(rulesPath path.Path, names []types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := map[string]bool{}
+	for i, name := range names {
+		if name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if seen[name.ValueString()] {
+			diags.AddAttributeError(
+				rulesPath.AtListIndex(i).AtName("name"),
+				"Duplicate Rule Name",
+				fmt.Sprintf("The rule name %q is used by more than one rule. Rule names must be unique.", name.ValueString()),
+			)
+		}
+		seen[name.ValueString()] = true
+	}
+
+	return diags
+}
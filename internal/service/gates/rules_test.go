@@ -0,0 +1,169 @@
+package gates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestRuleIDFromState(t *testing.T) {
+	ctx := context.Background()
+	schemas := commontest.NewResource(t, &GateResource{})
+	gateType := schemas.Type()
+
+	state := tfsdk.State{Schema: schemas.Schema, Raw: schemas.Value(map[string]tftypes.Value{
+		"rule": testRulesValue(gateType, testRuleValue(gateType, "rule1", "employees"), testRuleValue(gateType, "rule2", "beta")),
+	})}
+	// The rules are reordered, and a new rule is inserted between them.
+	plan := tfsdk.Plan{Schema: schemas.Schema, Raw: schemas.Value(map[string]tftypes.Value{
+		"rule": testRulesValue(gateType,
+			testRuleValue(gateType, tftypes.UnknownValue, "beta"),
+			testRuleValue(gateType, tftypes.UnknownValue, "partners"),
+			testRuleValue(gateType, tftypes.UnknownValue, "employees"),
+		),
+	})}
+
+	testCases := []struct {
+		state    tfsdk.State
+		index    int
+		expected types.String
+	}{
+		{state: state, index: 0, expected: types.StringValue("rule2")},
+		{state: state, index: 1, expected: types.StringUnknown()},
+		{state: state, index: 2, expected: types.StringValue("rule1")},
+		// Nothing is carried over when the gate is created.
+		{state: schemas.NullState(), index: 0, expected: types.StringUnknown()},
+	}
+
+	for _, testCase := range testCases {
+		req := planmodifier.StringRequest{
+			Path:      path.Root("rule").AtListIndex(testCase.index).AtName("id"),
+			Plan:      plan,
+			PlanValue: types.StringUnknown(),
+			State:     testCase.state,
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+		RuleIDFromState().PlanModifyString(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		if !resp.PlanValue.Equal(testCase.expected) {
+			t.Errorf("rule %d: expected the ID %s, got %s", testCase.index, testCase.expected, resp.PlanValue)
+		}
+	}
+}
+
+func TestRulesFromAPI(t *testing.T) {
+	ctx := context.Background()
+	emptySet := types.SetValueMust(types.StringType, []attr.Value{})
+
+	prior := []Rule{
+		{Name: types.StringValue("employees"), Environments: emptySet},
+		{Name: types.StringValue("beta"), Environments: types.SetNull(types.StringType)},
+	}
+	apiRules := []statsig.RuleAPIRequest{
+		{ID: "rule2", Name: "beta"},
+		{ID: "rule3", Name: "staging", Environments: []string{"staging"}},
+		{ID: "rule1", Name: "employees"},
+		{ID: "rule4", Name: "partners"},
+	}
+
+	rules, diags := RulesFromAPI(ctx, apiRules, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := []struct {
+		id           string
+		environments types.Set
+	}{
+		{id: "rule2", environments: types.SetNull(types.StringType)},
+		{id: "rule3", environments: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("staging")})},
+		{id: "rule1", environments: emptySet},
+		{id: "rule4", environments: types.SetNull(types.StringType)},
+	}
+	if len(rules) != len(expected) {
+		t.Fatalf("expected %d rules, got: %+v", len(expected), rules)
+	}
+
+	// The rules keep the evaluation order of the API.
+	for i, rule := range rules {
+		if rule.ID.ValueString() != expected[i].id {
+			t.Errorf("rule %d: expected the ID %q, got %s", i, expected[i].id, rule.ID)
+		}
+		if !rule.Environments.Equal(expected[i].environments) {
+			t.Errorf("rule %d: expected the environments %s, got %s", i, expected[i].environments, rule.Environments)
+		}
+	}
+}
+
+func TestGateResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	schemas := commontest.NewResource(t, &GateResource{})
+	gateType := schemas.Type()
+	rulesType := gateType.AttributeTypes["rule"]
+
+	testCases := map[string]struct {
+		rules    tftypes.Value
+		expected bool
+	}{
+		"unique names": {
+			rules: testRulesValue(gateType, testRuleValue(gateType, nil, "employees"), testRuleValue(gateType, nil, "beta")),
+		},
+		"duplicate names": {
+			rules:    testRulesValue(gateType, testRuleValue(gateType, nil, "employees"), testRuleValue(gateType, nil, "employees")),
+			expected: true,
+		},
+		"unknown rules": {
+			rules: tftypes.NewValue(rulesType, tftypes.UnknownValue),
+		},
+		"unknown rule": {
+			rules: testRulesValue(gateType,
+				testRuleValue(gateType, nil, "employees"),
+				tftypes.NewValue(rulesType.(tftypes.List).ElementType, tftypes.UnknownValue),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{
+				Schema: schemas.Schema,
+				Raw:    schemas.Value(map[string]tftypes.Value{"rule": testCase.rules}),
+			}}
+			resp := &resource.ValidateConfigResponse{}
+
+			(&GateResource{}).ValidateConfig(ctx, req, resp)
+			if resp.Diagnostics.HasError() != testCase.expected {
+				t.Errorf("expected an error: %t, got: %v", testCase.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testRulesValue returns the rule blocks of a gate.
+func testRulesValue(gateType tftypes.Object, rules ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(gateType.AttributeTypes["rule"], rules)
+}
+
+// testRuleValue returns a rule block of a gate with the given ID, which may be null or unknown, and name.
+func testRuleValue(gateType tftypes.Object, id any, name string) tftypes.Value {
+	ruleType := gateType.AttributeTypes["rule"].(tftypes.List).ElementType.(tftypes.Object)
+
+	return commontest.ObjectValue(ruleType, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, id),
+		"name":            tftypes.NewValue(tftypes.String, name),
+		"pass_percentage": tftypes.NewValue(tftypes.Number, 100),
+		"condition":       tftypes.NewValue(ruleType.AttributeTypes["condition"], []tftypes.Value{}),
+	})
+}
@@ -0,0 +1,77 @@
+package holdouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &HoldoutListResource{}
+	_ list.ListResourceWithConfigure = &HoldoutListResource{}
+)
+
+func NewHoldoutListResource() list.ListResource {
+	return &HoldoutListResource{}
+}
+
+// HoldoutListResource lists the holdouts of the Statsig Project, so they can be imported with `terraform query`.
+type HoldoutListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *HoldoutListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holdout"
+}
+
+func (r *HoldoutListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the holdouts in the Statsig Project.",
+	}
+}
+
+func (r *HoldoutListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every holdout of the project, up to the limit of the query.
+func (r *HoldoutListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	holdouts, err := r.client.GetHoldouts(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, holdouts,
+		func(holdout statsig.HoldoutAPIRequest) (string, string) {
+			return holdout.ID, holdout.Name
+		},
+		func(ctx context.Context, holdout statsig.HoldoutAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newHoldoutFromAPI(ctx, &holdout)
+			return HoldoutResourceModel{Holdout: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
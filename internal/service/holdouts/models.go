@@ -0,0 +1,79 @@
+package holdouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// HoldoutResourceModel describes the resource data model.
+type HoldoutResourceModel struct {
+	Holdout
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Holdout struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	Description    types.String  `tfsdk:"description"`
+	IDType         types.String  `tfsdk:"id_type"`
+	PassPercentage types.Float64 `tfsdk:"pass_percentage"`
+	IsGlobal       types.Bool    `tfsdk:"is_global"`
+	Gates          types.Set     `tfsdk:"gates"`
+	Experiments    types.Set     `tfsdk:"experiments"`
+	Layers         types.Set     `tfsdk:"layers"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+func (h *Holdout) toAPIRequest(ctx context.Context) (statsig.HoldoutAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.HoldoutAPIRequest{
+		ID:             h.ID.ValueString(),
+		Name:           h.Name.ValueString(),
+		Description:    h.Description.ValueString(),
+		IDType:         h.IDType.ValueString(),
+		PassPercentage: h.PassPercentage.ValueFloat64(),
+		IsGlobal:       h.IsGlobal.ValueBool(),
+	}
+
+	gates, d := common.StringSetElements(ctx, h.Gates)
+	diags.Append(d...)
+	apiReq.GateIDs = gates
+	experiments, d := common.StringSetElements(ctx, h.Experiments)
+	diags.Append(d...)
+	apiReq.ExperimentIDs = experiments
+	layers, d := common.StringSetElements(ctx, h.Layers)
+	diags.Append(d...)
+	apiReq.LayerIDs = layers
+
+	return apiReq, diags
+}
+
+// newHoldoutFromAPI maps the API response model to the Terraform model.
+func newHoldoutFromAPI(ctx context.Context, holdout *statsig.HoldoutAPIRequest) (Holdout, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	gates, d := common.StringSetValue(ctx, holdout.GateIDs)
+	diags.Append(d...)
+	experiments, d := common.StringSetValue(ctx, holdout.ExperimentIDs)
+	diags.Append(d...)
+	layers, d := common.StringSetValue(ctx, holdout.LayerIDs)
+	diags.Append(d...)
+
+	return Holdout{
+		ID:             types.StringValue(holdout.ID),
+		Name:           types.StringValue(holdout.Name),
+		Description:    types.StringValue(holdout.Description),
+		IDType:         types.StringValue(holdout.IDType),
+		PassPercentage: types.Float64Value(holdout.PassPercentage),
+		IsGlobal:       types.BoolValue(holdout.IsGlobal),
+		Gates:          gates,
+		Experiments:    experiments,
+		Layers:         layers,
+	}, diags
+}
@@ -0,0 +1,357 @@
+package holdouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &HoldoutResource{}
+	_ resource.ResourceWithImportState    = &HoldoutResource{}
+	_ resource.ResourceWithIdentity       = &HoldoutResource{}
+	_ resource.ResourceWithConfigure      = &HoldoutResource{}
+	_ resource.ResourceWithValidateConfig = &HoldoutResource{}
+)
+
+func NewHoldoutResource() resource.Resource {
+	return &HoldoutResource{}
+}
+
+type HoldoutResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *HoldoutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holdout"
+}
+
+func (r *HoldoutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a holdout in the Statsig Project. A holdout keeps a percentage of users out of the " +
+			"gates, experiments and layers it applies to, to measure their combined impact.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the holdout ID from the name when it is created, so a holdout cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the holdout. Changing the name forces a new holdout to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the holdout",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type users are held out by, such as `userID` or `stableID`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("userID"),
+			},
+			"pass_percentage": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of users held out, between 0 and 100",
+				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"is_global": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the holdout applies to every gate and experiment in the project. " +
+					"A global holdout cannot list gates, experiments or layers.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			// The targets are attached and detached by updating the holdout, so changing them never replaces it.
+			"gates": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the gates the holdout applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"experiments": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the experiments the holdout applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"layers": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the layers the holdout applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the holdout",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *HoldoutResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *HoldoutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures a global holdout does not list any gates, experiments or layers, as it already
+// applies to all of them.
+func (r *HoldoutResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config HoldoutResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IsGlobal.ValueBool() {
+		return
+	}
+
+	targets := map[string]types.Set{
+		"gates":       config.Gates,
+		"experiments": config.Experiments,
+		"layers":      config.Layers,
+	}
+	for name, target := range targets {
+		if !target.IsNull() && !target.IsUnknown() && len(target.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Holdout Configuration",
+				fmt.Sprintf("A global holdout applies to every gate, experiment and layer in the project, so %s cannot be set when is_global is true.", name),
+			)
+		}
+	}
+}
+
+// Create builds a new holdout with the provided attributes.
+func (r *HoldoutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan HoldoutResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the holdout
+	holdout, err := r.client.CreateHoldout(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create holdout, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the holdout attributes
+	plan.Holdout, diags = newHoldoutFromAPI(ctx, holdout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout created with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Read fetches the holdout from the API and updates the Terraform state with the holdout attributes.
+func (r *HoldoutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state HoldoutResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the holdout from the API
+	holdout, err := r.client.GetHoldout(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Holdout %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the state with the holdout attributes
+	state.Holdout, diags = newHoldoutFromAPI(ctx, holdout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the holdout as specified in the Terraform plan, attaching and detaching
+// gates, experiments and layers in place.
+func (r *HoldoutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan HoldoutResourceModel
+	var state HoldoutResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the holdout
+	holdout, err := r.client.UpdateHoldout(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Holdout",
+			fmt.Sprintf("Unable to update holdout, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the holdout attributes
+	plan.Holdout, diags = newHoldoutFromAPI(ctx, holdout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *HoldoutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state HoldoutResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteHoldout(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Holdout",
+			"Unable to delete holdout, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing holdout by its ID. The remaining attributes are populated by Read.
+func (r *HoldoutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
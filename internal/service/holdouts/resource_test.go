@@ -0,0 +1,153 @@
+package holdouts
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+func TestHoldoutResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &HoldoutResource{}
+	schemas := commontest.NewResource(t, r)
+
+	testCases := map[string]struct {
+		values   map[string]tftypes.Value
+		expected []path.Path
+	}{
+		"global": {
+			values: map[string]tftypes.Value{"is_global": tftypes.NewValue(tftypes.Bool, true)},
+		},
+		"global with empty targets": {
+			values: map[string]tftypes.Value{
+				"is_global": tftypes.NewValue(tftypes.Bool, true),
+				"gates":     commontest.StringSetValue(),
+			},
+		},
+		"global with targets": {
+			values: map[string]tftypes.Value{
+				"is_global":   tftypes.NewValue(tftypes.Bool, true),
+				"gates":       commontest.StringSetValue("checkout"),
+				"experiments": commontest.StringSetValue("pricing"),
+			},
+			expected: []path.Path{path.Root("experiments"), path.Root("gates")},
+		},
+		"global with unknown targets": {
+			values: map[string]tftypes.Value{
+				"is_global": tftypes.NewValue(tftypes.Bool, true),
+				"layers":    tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+			},
+		},
+		"unknown global": {
+			values: map[string]tftypes.Value{
+				"is_global": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"gates":     commontest.StringSetValue("checkout"),
+			},
+		},
+		"not global with targets": {
+			values: map[string]tftypes.Value{
+				"is_global": tftypes.NewValue(tftypes.Bool, false),
+				"gates":     commontest.StringSetValue("checkout"),
+				"layers":    commontest.StringSetValue("checkout_layer"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{
+				Schema: schemas.Schema,
+				Raw:    schemas.Value(testCase.values),
+			}}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+
+			var paths []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					paths = append(paths, withPath.Path())
+				}
+			}
+			slices.SortFunc(paths, func(a, b path.Path) int {
+				return strings.Compare(a.String(), b.String())
+			})
+			if !slices.EqualFunc(paths, testCase.expected, path.Path.Equal) {
+				t.Errorf("expected errors on %v, got: %v", testCase.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestHoldoutResourceUpdateTargets(t *testing.T) {
+	ctx := context.Background()
+	api := statsigfake.New()
+	if _, err := api.CreateHoldout(ctx, statsig.HoldoutAPIRequest{Name: "q3_holdout", IDType: "userID", PassPercentage: 5, GateIDs: []string{"checkout"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &HoldoutResource{client: api}
+	schemas := commontest.NewResource(t, r)
+
+	holdoutValue := func(gates tftypes.Value, experiments tftypes.Value, layers tftypes.Value) tftypes.Value {
+		return schemas.Value(map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, "q3_holdout"),
+			"name":            tftypes.NewValue(tftypes.String, "q3_holdout"),
+			"description":     tftypes.NewValue(tftypes.String, ""),
+			"id_type":         tftypes.NewValue(tftypes.String, "userID"),
+			"pass_percentage": tftypes.NewValue(tftypes.Number, 5),
+			"is_global":       tftypes.NewValue(tftypes.Bool, false),
+			"gates":           gates,
+			"experiments":     experiments,
+			"layers":          layers,
+		})
+	}
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemas.Schema, Raw: holdoutValue(commontest.StringSetValue("checkout"), commontest.StringSetValue(), commontest.StringSetValue())},
+		Plan:  tfsdk.Plan{Schema: schemas.Schema, Raw: holdoutValue(commontest.StringSetValue("search"), commontest.StringSetValue("pricing"), commontest.StringSetValue("checkout_layer"))},
+	}
+	resp := &resource.UpdateResponse{
+		State:    tfsdk.State{Schema: schemas.Schema, Raw: req.State.Raw},
+		Identity: schemas.NullIdentity(),
+	}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// The targets are changed on the existing holdout.
+	holdout, err := api.GetHoldout(ctx, "q3_holdout")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(holdout.GateIDs, []string{"search"}) || !slices.Equal(holdout.ExperimentIDs, []string{"pricing"}) || !slices.Equal(holdout.LayerIDs, []string{"checkout_layer"}) {
+		t.Errorf("expected the targets of the holdout to be updated, got %+v", holdout)
+	}
+
+	var state HoldoutResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if state.ID.ValueString() != "q3_holdout" || len(state.Gates.Elements()) != 1 || len(state.Experiments.Elements()) != 1 || len(state.Layers.Elements()) != 1 {
+		t.Errorf("expected the state to hold the updated targets, got %+v", state.Holdout)
+	}
+
+	// Changing the targets never replaces the holdout.
+	for _, name := range []string{"gates", "experiments", "layers"} {
+		if attribute, ok := schemas.Schema.Attributes[name].(schema.SetAttribute); !ok || len(attribute.PlanModifiers) > 0 {
+			t.Errorf("expected %s to be a set without plan modifiers", name)
+		}
+	}
+}
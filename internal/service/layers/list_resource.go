@@ -0,0 +1,77 @@
+package layers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &LayerListResource{}
+	_ list.ListResourceWithConfigure = &LayerListResource{}
+)
+
+func NewLayerListResource() list.ListResource {
+	return &LayerListResource{}
+}
+
+// LayerListResource lists the layers of the Statsig Project, so they can be imported with `terraform query`.
+type LayerListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *LayerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_layer"
+}
+
+func (r *LayerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the layers in the Statsig Project.",
+	}
+}
+
+func (r *LayerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every layer of the project, up to the limit of the query.
+func (r *LayerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	layers, err := r.client.GetLayers(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, layers,
+		func(layer statsig.LayerAPIRequest) (string, string) {
+			return layer.ID, layer.Name
+		},
+		func(ctx context.Context, layer statsig.LayerAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newLayerFromAPI(ctx, &layer)
+			return LayerResourceModel{Layer: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
@@ -0,0 +1,102 @@
+package layers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// LayerResourceModel describes the resource data model.
+type LayerResourceModel struct {
+	Layer
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Layer struct {
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	IDType      types.String     `tfsdk:"id_type"`
+	Experiments types.Set        `tfsdk:"experiments"`
+	Parameters  []LayerParameter `tfsdk:"parameter"`
+}
+
+type LayerParameter struct {
+	Name         types.String         `tfsdk:"name"`
+	Type         types.String         `tfsdk:"type"`
+	DefaultValue jsontypes.Normalized `tfsdk:"default_value"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model. The experiments of a layer are
+// managed through the layer_id attribute of each experiment, so they are not sent.
+func (l *Layer) toAPIRequest() statsig.LayerAPIRequest {
+	apiReq := statsig.LayerAPIRequest{
+		ID:          l.ID.ValueString(),
+		Name:        l.Name.ValueString(),
+		Description: l.Description.ValueString(),
+		IDType:      l.IDType.ValueString(),
+		Parameters:  []statsig.LayerParameterAPIRequest{},
+	}
+
+	for _, parameter := range l.Parameters {
+		apiReq.Parameters = append(apiReq.Parameters, statsig.LayerParameterAPIRequest{
+			Name:         parameter.Name.ValueString(),
+			Type:         parameter.Type.ValueString(),
+			DefaultValue: json.RawMessage(parameter.DefaultValue.ValueString()),
+		})
+	}
+
+	return apiReq
+}
+
+// newLayerFromAPI maps the API response model to the Terraform model.
+func newLayerFromAPI(ctx context.Context, layer *statsig.LayerAPIRequest) (Layer, diag.Diagnostics) {
+	experiments, diags := common.StringSetValue(ctx, layer.Experiments)
+
+	var parameters []LayerParameter
+	for _, parameter := range layer.Parameters {
+		parameters = append(parameters, LayerParameter{
+			Name:         types.StringValue(parameter.Name),
+			Type:         types.StringValue(parameter.Type),
+			DefaultValue: jsontypes.NewNormalizedValue(string(parameter.DefaultValue)),
+		})
+	}
+
+	return Layer{
+		ID:          types.StringValue(layer.ID),
+		Name:        types.StringValue(layer.Name),
+		Description: types.StringValue(layer.Description),
+		IDType:      types.StringValue(layer.IDType),
+		Experiments: experiments,
+		Parameters:  parameters,
+	}, diags
+}
+
+// matchesParameterType reports whether the JSON-encoded value is of the provided layer parameter type.
+func matchesParameterType(parameterType string, value string) bool {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return false
+	}
+
+	switch decoded.(type) {
+	case string:
+		return parameterType == "string"
+	case float64:
+		return parameterType == "number"
+	case bool:
+		return parameterType == "boolean"
+	case map[string]interface{}:
+		return parameterType == "object"
+	case []interface{}:
+		return parameterType == "array"
+	default:
+		return false
+	}
+}
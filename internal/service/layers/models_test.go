@@ -0,0 +1,29 @@
+package layers
+
+import "testing"
+
+func TestMatchesParameterType(t *testing.T) {
+	testCases := []struct {
+		parameterType string
+		value         string
+		expected      bool
+	}{
+		{parameterType: "string", value: `"light"`, expected: true},
+		{parameterType: "string", value: `10`, expected: false},
+		{parameterType: "number", value: `10`, expected: true},
+		{parameterType: "number", value: `"10"`, expected: false},
+		{parameterType: "boolean", value: `false`, expected: true},
+		{parameterType: "boolean", value: `"false"`, expected: false},
+		{parameterType: "object", value: `{"a": 1}`, expected: true},
+		{parameterType: "object", value: `[1]`, expected: false},
+		{parameterType: "array", value: `[1, "a"]`, expected: true},
+		{parameterType: "array", value: `null`, expected: false},
+		{parameterType: "string", value: `not json`, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := matchesParameterType(testCase.parameterType, testCase.value); got != testCase.expected {
+			t.Errorf("matchesParameterType(%q, %q): expected %t, got %t", testCase.parameterType, testCase.value, testCase.expected, got)
+		}
+	}
+}
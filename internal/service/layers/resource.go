@@ -0,0 +1,384 @@
+package layers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &LayerResource{}
+	_ resource.ResourceWithImportState    = &LayerResource{}
+	_ resource.ResourceWithIdentity       = &LayerResource{}
+	_ resource.ResourceWithConfigure      = &LayerResource{}
+	_ resource.ResourceWithValidateConfig = &LayerResource{}
+)
+
+// parameterTypes are the types of the values a layer parameter can hold.
+var parameterTypes = []string{"string", "number", "boolean", "object", "array"}
+
+func NewLayerResource() resource.Resource {
+	return &LayerResource{}
+}
+
+type LayerResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *LayerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_layer"
+}
+
+func (r *LayerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a layer in the Statsig Project. Experiments in the same layer are mutually exclusive, " +
+			"and share the parameters declared by the layer.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the layer ID from the name when it is created, so a layer cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the layer. Changing the name forces a new layer to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the layer",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type users are allocated by, such as `userID` or `stableID`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("userID"),
+			},
+			// Experiments are added to a layer through their own layer_id attribute.
+			"experiments": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the experiments in the layer. Use the `layer_id` attribute of `statsig_experiment` to add an experiment to the layer.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the layer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"parameter": schema.ListNestedBlock{
+				MarkdownDescription: "The parameters of the layer. Experiments in the layer override their default values for their groups.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the parameter",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the parameter: `string`, `number`, `boolean`, `object` or `array`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(parameterTypes...),
+							},
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "The JSON-encoded value returned to users that are not in an experiment, " +
+								"such as the output of `jsonencode(true)`. The value must match the parameter type.",
+							CustomType: jsontypes.NormalizedType{},
+							Required:   true,
+						},
+					},
+				},
+			},
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *LayerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *LayerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures the default value of every parameter matches the parameter type.
+func (r *LayerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var parameterList types.List
+
+	// The parameters are unknown when they are generated by a dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parameter"), &parameterList)...)
+	if resp.Diagnostics.HasError() || parameterList.IsUnknown() || parameterList.IsNull() {
+		return
+	}
+
+	var parameters []LayerParameter
+	resp.Diagnostics.Append(parameterList.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, parameter := range parameters {
+		if parameter.Type.IsUnknown() || parameter.DefaultValue.IsUnknown() || parameter.DefaultValue.IsNull() {
+			continue
+		}
+
+		if !matchesParameterType(parameter.Type.ValueString(), parameter.DefaultValue.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parameter").AtListIndex(i).AtName("default_value"),
+				"Invalid Parameter Default Value",
+				fmt.Sprintf("The default value of parameter %q must be a JSON-encoded %s, got: %s",
+					parameter.Name.ValueString(), parameter.Type.ValueString(), parameter.DefaultValue.ValueString()),
+			)
+		}
+	}
+}
+
+// Create builds a new layer with the provided attributes.
+func (r *LayerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LayerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the layer
+	layer, err := r.client.CreateLayer(ctx, plan.toAPIRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create layer, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the layer attributes
+	plan.Layer, diags = newLayerFromAPI(ctx, layer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer created with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Read fetches the layer from the API and updates the Terraform state with the layer attributes.
+func (r *LayerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LayerResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the layer from the API
+	layer, err := r.client.GetLayer(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Layer %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the state with the layer attributes
+	state.Layer, diags = newLayerFromAPI(ctx, layer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the layer as specified in the Terraform plan.
+func (r *LayerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan LayerResourceModel
+	var state LayerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update the layer
+	layer, err := r.client.UpdateLayer(ctx, state.ID.ValueString(), plan.toAPIRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Layer",
+			fmt.Sprintf("Unable to update layer, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the layer attributes
+	plan.Layer, diags = newLayerFromAPI(ctx, layer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Delete removes the layer from the project.
+//
+// Statsig refuses to delete a layer that still contains experiments. The reason given by the API is
+// surfaced along with the experiments that are still in the layer, so the user knows what to remove first.
+// Other refusals are reported with the reason alone.
+func (r *LayerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LayerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteLayer(ctx, state.ID.ValueString())
+	if err == nil {
+		return
+	}
+
+	var apiErr *statsig.APIError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusBadRequest && !statsig.IsConflict(err)) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Layer",
+			"Unable to delete layer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Prefer the current experiments over the ones in the state, as they may have changed since the last refresh.
+	var experiments []string
+	if layer, err := r.client.GetLayer(ctx, state.ID.ValueString()); err == nil {
+		experiments = layer.Experiments
+	} else {
+		resp.Diagnostics.Append(state.Experiments.ElementsAs(ctx, &experiments, false)...)
+	}
+
+	if len(experiments) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Deleting Layer",
+			fmt.Sprintf("Statsig refused to delete layer %q: %s", state.ID.ValueString(), apiErr.Message),
+		)
+		return
+	}
+
+	sort.Strings(experiments)
+	resp.Diagnostics.AddError(
+		"Layer Still Contains Experiments",
+		fmt.Sprintf("Statsig refused to delete layer %q: %s\n\n", state.ID.ValueString(), apiErr.Message)+
+			fmt.Sprintf("The layer still contains the following experiments: %s.\n", strings.Join(experiments, ", "))+
+			"Delete the experiments in the layer, or move them to another layer with their layer_id attribute, before deleting the layer.",
+	)
+}
+
+// ImportState imports an existing layer by its ID. The remaining attributes are populated by Read.
+func (r *LayerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,92 @@
+package layers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+// refusingAPI refuses to delete any layer, for a reason other than the experiments it contains.
+type refusingAPI struct {
+	*statsigfake.API
+}
+
+func (a refusingAPI) DeleteLayer(_ context.Context, layerID string) error {
+	return &statsig.APIError{StatusCode: http.StatusBadRequest, Message: "layer " + layerID + " is used by a holdout"}
+}
+
+func TestLayerResourceDelete(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		experiments []string
+		refuse      bool
+		summary     string
+		detail      []string
+	}{
+		"deleted": {},
+		"contains experiments": {
+			experiments: []string{"pricing", "checkout"},
+			summary:     "Layer Still Contains Experiments",
+			detail:      []string{"still contains experiments", "checkout, pricing", "layer_id"},
+		},
+		"refused": {
+			refuse:  true,
+			summary: "Error Deleting Layer",
+			detail:  []string{"layer checkout_layer is used by a holdout"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := statsigfake.New()
+			if _, err := api.CreateLayer(ctx, statsig.LayerAPIRequest{ID: "checkout_layer", Name: "Checkout Layer", Experiments: testCase.experiments}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r := &LayerResource{client: api}
+			if testCase.refuse {
+				r.client = refusingAPI{api}
+			}
+
+			schemas := commontest.NewResource(t, r)
+			state := tfsdk.State{Schema: schemas.Schema, Raw: schemas.Value(map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "checkout_layer"),
+			})}
+
+			resp := &resource.DeleteResponse{}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			if testCase.summary == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				if _, err := api.GetLayer(ctx, "checkout_layer"); !statsig.IsNotFound(err) {
+					t.Errorf("expected the layer to be deleted, got: %v", err)
+				}
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected an error, got: %v", resp.Diagnostics)
+			}
+			diagnostic := resp.Diagnostics.Errors()[0]
+			if diagnostic.Summary() != testCase.summary {
+				t.Errorf("expected the summary %q, got %q", testCase.summary, diagnostic.Summary())
+			}
+			for _, detail := range testCase.detail {
+				if !strings.Contains(diagnostic.Detail(), detail) {
+					t.Errorf("expected the detail to contain %q, got: %s", detail, diagnostic.Detail())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package metric_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &MetricSourceListResource{}
+	_ list.ListResourceWithConfigure = &MetricSourceListResource{}
+)
+
+func NewMetricSourceListResource() list.ListResource {
+	return &MetricSourceListResource{}
+}
+
+// MetricSourceListResource lists the metric sources of the Statsig Project, so they can be imported with `terraform query`.
+type MetricSourceListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *MetricSourceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_source"
+}
+
+func (r *MetricSourceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the metric sources in the Statsig Project.",
+	}
+}
+
+func (r *MetricSourceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every metric source of the project, up to the limit of the query.
+func (r *MetricSourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	sources, err := r.client.GetMetricSources(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, sources,
+		func(source statsig.MetricSourceAPIRequest) (string, string) {
+			return source.Name, source.Name
+		},
+		func(ctx context.Context, source statsig.MetricSourceAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newMetricSourceFromAPI(ctx, &source)
+			return MetricSourceResourceModel{MetricSource: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
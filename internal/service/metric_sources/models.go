@@ -0,0 +1,85 @@
+package metric_sources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// MetricSourceResourceModel describes the resource data model.
+type MetricSourceResourceModel struct {
+	MetricSource
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type MetricSource struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	SQL             types.String `tfsdk:"sql"`
+	TimestampColumn types.String `tfsdk:"timestamp_column"`
+	Tags            types.Set    `tfsdk:"tags"`
+	IDTypeMapping   []IDType     `tfsdk:"id_type_mapping"`
+}
+
+type IDType struct {
+	IDType types.String `tfsdk:"id_type"`
+	Column types.String `tfsdk:"column"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+func (s *MetricSource) toAPIRequest(ctx context.Context) (statsig.MetricSourceAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.MetricSourceAPIRequest{
+		Name:            s.Name.ValueString(),
+		Description:     s.Description.ValueString(),
+		SQL:             s.SQL.ValueString(),
+		TimestampColumn: s.TimestampColumn.ValueString(),
+		IDTypeMapping:   make([]statsig.MetricSourceIDTypeAPIRequest, 0, len(s.IDTypeMapping)),
+	}
+
+	for _, mapping := range s.IDTypeMapping {
+		apiReq.IDTypeMapping = append(apiReq.IDTypeMapping, statsig.MetricSourceIDTypeAPIRequest{
+			StatsigUnitID: mapping.IDType.ValueString(),
+			Column:        mapping.Column.ValueString(),
+		})
+	}
+
+	tags, d := common.StringSetElements(ctx, s.Tags)
+	diags.Append(d...)
+	apiReq.Tags = tags
+
+	return apiReq, diags
+}
+
+// newMetricSourceFromAPI maps the API response model to the Terraform model. Metric sources are
+// identified by their name, so the name is also used as the ID.
+func newMetricSourceFromAPI(ctx context.Context, source *statsig.MetricSourceAPIRequest) (MetricSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := common.StringSetValue(ctx, source.Tags)
+	diags.Append(d...)
+
+	mappings := make([]IDType, 0, len(source.IDTypeMapping))
+	for _, mapping := range source.IDTypeMapping {
+		mappings = append(mappings, IDType{
+			IDType: types.StringValue(mapping.StatsigUnitID),
+			Column: types.StringValue(mapping.Column),
+		})
+	}
+
+	return MetricSource{
+		ID:              types.StringValue(source.Name),
+		Name:            types.StringValue(source.Name),
+		Description:     types.StringValue(source.Description),
+		SQL:             types.StringValue(source.SQL),
+		TimestampColumn: types.StringValue(source.TimestampColumn),
+		Tags:            tags,
+		IDTypeMapping:   mappings,
+	}, diags
+}
@@ -0,0 +1,347 @@
+package metric_sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &MetricSourceResource{}
+	_ resource.ResourceWithImportState    = &MetricSourceResource{}
+	_ resource.ResourceWithIdentity       = &MetricSourceResource{}
+	_ resource.ResourceWithConfigure      = &MetricSourceResource{}
+	_ resource.ResourceWithValidateConfig = &MetricSourceResource{}
+)
+
+func NewMetricSourceResource() resource.Resource {
+	return &MetricSourceResource{}
+}
+
+type MetricSourceResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *MetricSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_source"
+}
+
+func (r *MetricSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a warehouse native metric source in the Statsig Project. A metric source reads " +
+			"the rows that metrics are computed from out of the warehouse with a SQL query.",
+
+		Attributes: map[string]schema.Attribute{
+			// Metric sources are identified by their name, so a metric source cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the metric source. Changing the name forces a new metric source to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the metric source",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"sql": schema.StringAttribute{
+				MarkdownDescription: "The SQL query that reads the rows of the metric source from the warehouse",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"timestamp_column": schema.StringAttribute{
+				MarkdownDescription: "The column of the query holding the time of each row",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "The names of the tags applied to the metric source",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the metric source, which is its name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"id_type_mapping": schema.ListNestedBlock{
+				MarkdownDescription: "Maps the unit ID types of the project to the columns of the query holding them",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id_type": schema.StringAttribute{
+							MarkdownDescription: "The unit ID type, such as `userID` or `stableID`",
+							Required:            true,
+						},
+						"column": schema.StringAttribute{
+							MarkdownDescription: "The column of the query holding the unit ID",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *MetricSourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *MetricSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures every unit ID type is only mapped to a single column.
+func (r *MetricSourceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mappings []IDType
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id_type_mapping"), &mappings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(mappings))
+	for i, mapping := range mappings {
+		if mapping.IDType.IsNull() || mapping.IDType.IsUnknown() {
+			continue
+		}
+
+		idType := mapping.IDType.ValueString()
+		if seen[idType] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id_type_mapping").AtListIndex(i).AtName("id_type"),
+				"Duplicate ID Type Mapping",
+				fmt.Sprintf("The ID type %q is mapped more than once. Each ID type can only be mapped to a single column.", idType),
+			)
+		}
+		seen[idType] = true
+	}
+}
+
+// Create builds a new metric source with the provided attributes.
+func (r *MetricSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MetricSourceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the metric source
+	source, err := r.client.CreateMetricSource(ctx, apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create metric source, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the metric source attributes
+	plan.MetricSource, diags = newMetricSourceFromAPI(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source created with Name: %s", plan.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+// Read fetches the metric source from the API and updates the Terraform state with its attributes.
+func (r *MetricSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MetricSourceResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the metric source from the API
+	source, err := r.client.GetMetricSource(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Metric source %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	// Update the state with the metric source attributes
+	state.MetricSource, diags = newMetricSourceFromAPI(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the metric source as specified in the Terraform plan.
+func (r *MetricSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MetricSourceResourceModel
+	var state MetricSourceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the metric source
+	source, err := r.client.UpdateMetricSource(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Metric Source",
+			fmt.Sprintf("Unable to update metric source, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the metric source attributes
+	plan.MetricSource, diags = newMetricSourceFromAPI(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source updated with Name: %s", plan.Name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *MetricSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MetricSourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteMetricSource(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Metric Source",
+			"Unable to delete metric source, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing metric source by its name. The remaining attributes are populated by Read.
+func (r *MetricSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &MetricListResource{}
+	_ list.ListResourceWithConfigure = &MetricListResource{}
+)
+
+func NewMetricListResource() list.ListResource {
+	return &MetricListResource{}
+}
+
+// MetricListResource lists the metrics of the Statsig Project, so they can be imported with `terraform query`.
+type MetricListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *MetricListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric"
+}
+
+func (r *MetricListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the metrics in the Statsig Project.",
+	}
+}
+
+func (r *MetricListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every metric of the project, up to the limit of the query.
+func (r *MetricListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	metrics, err := r.client.GetMetrics(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, metrics,
+		func(metric statsig.MetricAPIRequest) (string, string) {
+			return metric.ID, metric.Name
+		},
+		func(ctx context.Context, metric statsig.MetricAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newMetricFromAPI(ctx, &metric)
+			return MetricResourceModel{Metric: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
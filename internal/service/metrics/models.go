@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// MetricResourceModel describes the resource data model.
+type MetricResourceModel struct {
+	Metric
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Metric struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Type             types.String `tfsdk:"type"`
+	Tags             types.Set    `tfsdk:"tags"`
+	EventName        types.String `tfsdk:"event_name"`
+	ValueKey         types.String `tfsdk:"value_key"`
+	NumeratorEvent   types.String `tfsdk:"numerator_event"`
+	DenominatorEvent types.String `tfsdk:"denominator_event"`
+	FunnelEvents     types.List   `tfsdk:"funnel_events"`
+}
+
+// definitionAttributeNames are the attributes that define what a metric measures.
+var definitionAttributeNames = []string{"event_name", "value_key", "numerator_event", "denominator_event", "funnel_events"}
+
+// definitionAttributes lists, for every metric type, which of the definition attributes are required
+// and which are allowed. Any definition attribute not listed for a type is rejected.
+var definitionAttributes = map[string]struct {
+	required []string
+	optional []string
+}{
+	statsig.MetricTypeEventCount: {required: []string{"event_name"}},
+	statsig.MetricTypeSum:        {required: []string{"event_name"}, optional: []string{"value_key"}},
+	statsig.MetricTypeRatio:      {required: []string{"numerator_event", "denominator_event"}},
+	statsig.MetricTypeFunnel:     {required: []string{"funnel_events"}},
+}
+
+// definitionValues returns whether each definition attribute is set. Unknown values count as set, as
+// they will hold a value once applied.
+func (m *Metric) definitionValues() map[string]bool {
+	return map[string]bool{
+		"event_name":        !m.EventName.IsNull(),
+		"value_key":         !m.ValueKey.IsNull(),
+		"numerator_event":   !m.NumeratorEvent.IsNull(),
+		"denominator_event": !m.DenominatorEvent.IsNull(),
+		"funnel_events":     !m.FunnelEvents.IsNull(),
+	}
+}
+
+// validateDefinition ensures the definition attributes of the metric match its type, for example that a
+// ratio metric has both a numerator and a denominator event.
+func (m *Metric) validateDefinition() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if m.Type.IsNull() || m.Type.IsUnknown() {
+		return diags
+	}
+
+	metricType := m.Type.ValueString()
+	attributes, ok := definitionAttributes[metricType]
+	if !ok {
+		return diags
+	}
+
+	values := m.definitionValues()
+	allowed := make(map[string]bool, len(attributes.required)+len(attributes.optional))
+	for _, name := range attributes.required {
+		allowed[name] = true
+		if !values[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing Metric Definition",
+				fmt.Sprintf("The %s attribute is required for %s metrics.", name, metricType),
+			)
+		}
+	}
+	for _, name := range attributes.optional {
+		allowed[name] = true
+	}
+
+	for _, name := range definitionAttributeNames {
+		if values[name] && !allowed[name] {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid Metric Definition",
+				fmt.Sprintf("The %s attribute cannot be used with %s metrics.", name, metricType),
+			)
+		}
+	}
+
+	return diags
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+func (m *Metric) toAPIRequest(ctx context.Context) (statsig.MetricAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.MetricAPIRequest{
+		ID:               m.ID.ValueString(),
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueString(),
+		Type:             m.Type.ValueString(),
+		EventName:        m.EventName.ValueString(),
+		ValueKey:         m.ValueKey.ValueString(),
+		NumeratorEvent:   m.NumeratorEvent.ValueString(),
+		DenominatorEvent: m.DenominatorEvent.ValueString(),
+	}
+
+	tags, d := common.StringSetElements(ctx, m.Tags)
+	diags.Append(d...)
+	apiReq.Tags = tags
+
+	if !m.FunnelEvents.IsNull() && !m.FunnelEvents.IsUnknown() {
+		diags.Append(m.FunnelEvents.ElementsAs(ctx, &apiReq.FunnelEvents, false)...)
+	}
+
+	return apiReq, diags
+}
+
+// newMetricFromAPI maps the API response model to the Terraform model.
+func newMetricFromAPI(ctx context.Context, metric *statsig.MetricAPIRequest) (Metric, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, d := common.StringSetValue(ctx, metric.Tags)
+	diags.Append(d...)
+
+	funnelEvents := types.ListNull(types.StringType)
+	if len(metric.FunnelEvents) > 0 {
+		funnelEvents, d = types.ListValueFrom(ctx, types.StringType, metric.FunnelEvents)
+		diags.Append(d...)
+	}
+
+	return Metric{
+		ID:               types.StringValue(metric.ID),
+		Name:             types.StringValue(metric.Name),
+		Description:      types.StringValue(metric.Description),
+		Type:             types.StringValue(metric.Type),
+		Tags:             tags,
+		EventName:        common.StringValueOrNull(metric.EventName),
+		ValueKey:         common.StringValueOrNull(metric.ValueKey),
+		NumeratorEvent:   common.StringValueOrNull(metric.NumeratorEvent),
+		DenominatorEvent: common.StringValueOrNull(metric.DenominatorEvent),
+		FunnelEvents:     funnelEvents,
+	}, diags
+}
@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	funnel := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("view"), types.StringValue("purchase")})
+
+	testCases := []struct {
+		name      string
+		metric    Metric
+		errorsFor []string
+	}{
+		{
+			name:   "event count",
+			metric: Metric{Type: types.StringValue("event_count"), EventName: types.StringValue("purchase")},
+		},
+		{
+			name:      "event count without event",
+			metric:    Metric{Type: types.StringValue("event_count")},
+			errorsFor: []string{"event_name"},
+		},
+		{
+			name:      "event count with value key",
+			metric:    Metric{Type: types.StringValue("event_count"), EventName: types.StringValue("purchase"), ValueKey: types.StringValue("price")},
+			errorsFor: []string{"value_key"},
+		},
+		{
+			name:   "sum with value key",
+			metric: Metric{Type: types.StringValue("sum"), EventName: types.StringValue("purchase"), ValueKey: types.StringValue("price")},
+		},
+		{
+			name:   "ratio",
+			metric: Metric{Type: types.StringValue("ratio"), NumeratorEvent: types.StringValue("purchase"), DenominatorEvent: types.StringValue("view")},
+		},
+		{
+			name:      "ratio without denominator",
+			metric:    Metric{Type: types.StringValue("ratio"), NumeratorEvent: types.StringValue("purchase")},
+			errorsFor: []string{"denominator_event"},
+		},
+		{
+			name:   "ratio with unknown denominator",
+			metric: Metric{Type: types.StringValue("ratio"), NumeratorEvent: types.StringValue("purchase"), DenominatorEvent: types.StringUnknown()},
+		},
+		{
+			name:   "funnel",
+			metric: Metric{Type: types.StringValue("funnel"), FunnelEvents: funnel},
+		},
+		{
+			name:      "funnel with event",
+			metric:    Metric{Type: types.StringValue("funnel"), FunnelEvents: funnel, EventName: types.StringValue("purchase")},
+			errorsFor: []string{"event_name"},
+		},
+		{
+			name:   "unknown type",
+			metric: Metric{Type: types.StringUnknown()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			diags := testCase.metric.validateDefinition()
+			if diags.ErrorsCount() != len(testCase.errorsFor) {
+				t.Fatalf("expected %d errors, got: %v", len(testCase.errorsFor), diags)
+			}
+			for i, attribute := range testCase.errorsFor {
+				withPath, ok := diags[i].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root(attribute)) {
+					t.Errorf("expected an error for %s, got: %v", attribute, diags[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package segments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &SegmentListResource{}
+	_ list.ListResourceWithConfigure = &SegmentListResource{}
+)
+
+func NewSegmentListResource() list.ListResource {
+	return &SegmentListResource{}
+}
+
+// SegmentListResource lists the segments of the Statsig Project, so they can be imported with `terraform query`.
+type SegmentListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *SegmentListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (r *SegmentListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the segments in the Statsig Project.",
+	}
+}
+
+func (r *SegmentListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every segment of the project, up to the limit of the query. The rules or IDs of each segment are
+// only retrieved when the full resources are requested.
+func (r *SegmentListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	segments, err := r.client.GetSegments(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, segments,
+		func(segment statsig.SegmentAPIRequest) (string, string) {
+			return segment.ID, segment.Name
+		},
+		func(ctx context.Context, segment statsig.SegmentAPIRequest) (any, diag.Diagnostics) {
+			state, diags := (&SegmentResource{client: r.client}).read(ctx, &segment, nil)
+			return SegmentResourceModel{Segment: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
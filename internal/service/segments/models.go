@@ -0,0 +1,91 @@
+package segments
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/gates"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// SegmentResourceModel describes the resource data model.
+type SegmentResourceModel struct {
+	Segment
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type Segment struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	IDType      types.String `tfsdk:"id_type"`
+	IDs         types.Set    `tfsdk:"ids"`
+	Rules       []gates.Rule `tfsdk:"rule"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model. The rules and IDs of the segment are
+// managed through their own endpoints.
+func (s *Segment) toAPIRequest() statsig.SegmentAPIRequest {
+	return statsig.SegmentAPIRequest{
+		ID:          s.ID.ValueString(),
+		Name:        s.Name.ValueString(),
+		Description: s.Description.ValueString(),
+		Type:        s.Type.ValueString(),
+		IDType:      s.IDType.ValueString(),
+	}
+}
+
+// newSegmentFromAPI maps the API response models to the Terraform model. The conditions are only
+// present for rule based segments, and the ID list only for ID list segments. prior holds the rules of
+// the plan or state the segment is read into, which decide how rules without environments are read.
+func newSegmentFromAPI(ctx context.Context, segment *statsig.SegmentAPIRequest, conditions *statsig.SegmentConditionsAPIRequest, idList *statsig.SegmentIDListAPIRequest, prior []gates.Rule) (Segment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rules []gates.Rule
+	if conditions != nil {
+		var d diag.Diagnostics
+		rules, d = gates.RulesFromAPI(ctx, conditions.Rules, prior)
+		diags.Append(d...)
+	}
+
+	var ids []string
+	if idList != nil {
+		ids = idList.IDs
+	}
+	idSet, d := common.StringSetValue(ctx, ids)
+	diags.Append(d...)
+
+	return Segment{
+		ID:          types.StringValue(segment.ID),
+		Name:        types.StringValue(segment.Name),
+		Description: types.StringValue(segment.Description),
+		Type:        types.StringValue(segment.Type),
+		IDType:      types.StringValue(segment.IDType),
+		IDs:         idSet,
+		Rules:       rules,
+	}, diags
+}
+
+// difference returns the sorted values of a that are not in b. Membership is tracked with a map, as ID
+// list segments can hold hundreds of thousands of IDs.
+func difference(a, b []string) []string {
+	inB := make(map[string]struct{}, len(b))
+	for _, value := range b {
+		inB[value] = struct{}{}
+	}
+
+	var result []string
+	for _, value := range a {
+		if _, ok := inB[value]; !ok {
+			result = append(result, value)
+		}
+	}
+
+	slices.Sort(result)
+	return result
+}
@@ -0,0 +1,25 @@
+package segments
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	testCases := []struct {
+		a        []string
+		b        []string
+		expected []string
+	}{
+		{a: []string{"c", "a", "b"}, b: []string{"b"}, expected: []string{"a", "c"}},
+		{a: []string{"a", "b"}, b: []string{"a", "b", "c"}, expected: nil},
+		{a: []string{"a"}, b: nil, expected: []string{"a"}},
+		{a: nil, b: []string{"a"}, expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		if got := difference(testCase.a, testCase.b); !slices.Equal(got, testCase.expected) {
+			t.Errorf("difference(%v, %v): expected %v, got %v", testCase.a, testCase.b, testCase.expected, got)
+		}
+	}
+}
@@ -0,0 +1,447 @@
+package segments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/gates"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &SegmentResource{}
+	_ resource.ResourceWithImportState    = &SegmentResource{}
+	_ resource.ResourceWithIdentity       = &SegmentResource{}
+	_ resource.ResourceWithConfigure      = &SegmentResource{}
+	_ resource.ResourceWithValidateConfig = &SegmentResource{}
+)
+
+func NewSegmentResource() resource.Resource {
+	return &SegmentResource{}
+}
+
+type SegmentResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *SegmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (r *SegmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create a segment in the Statsig Project. A segment is a reusable audience, " +
+			"defined either by rules or by a list of IDs, that gates can target with the `passes_segment` condition.",
+
+		Attributes: map[string]schema.Attribute{
+			// Statsig derives the segment ID from the name when it is created, so a segment cannot be renamed.
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the segment. Changing the name forces a new segment to be created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the segment",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the segment: `rule_based` segments use `rule` blocks, and `id_list` segments use `ids`. " +
+					"Changing the type forces a new segment to be created.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(statsig.SegmentTypeRuleBased, statsig.SegmentTypeIDList),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id_type": schema.StringAttribute{
+				MarkdownDescription: "The unit ID type of the segment, such as `userID` or `stableID`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("userID"),
+			},
+			// The IDs are a set, so the plan only shows the IDs being added or removed rather than the whole list.
+			"ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs in an `id_list` segment. IDs are added and removed in batches of " +
+					fmt.Sprintf("%d, so large lists are supported.", statsig.SegmentIDListChunkSize),
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the segment",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				MarkdownDescription: "The rules of a `rule_based` segment. A user is in the segment when they pass any of the rules.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: gates.RuleAttributes(),
+					Blocks: map[string]schema.Block{
+						"condition": gates.ConditionBlock(),
+					},
+				},
+			},
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *SegmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
+func (r *SegmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig ensures the rules and IDs are only used by the matching segment type, and that the
+// rule names are unique.
+func (r *SegmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var segmentType types.String
+	var ids types.Set
+	var rules types.List
+
+	// The rules are read as a list rather than decoded, as they are unknown when they are generated by a
+	// dynamic block over an unknown value.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &segmentType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ids"), &ids)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !rules.IsUnknown() {
+		resp.Diagnostics.Append(gates.ValidateUniqueRuleNames(path.Root("rule"), gates.RuleNames(rules))...)
+	}
+
+	if segmentType.IsUnknown() {
+		return
+	}
+
+	switch segmentType.ValueString() {
+	case statsig.SegmentTypeRuleBased:
+		if !ids.IsNull() && !ids.IsUnknown() && len(ids.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ids"),
+				"Invalid Segment Configuration",
+				"The ids attribute can only be used with id_list segments. Use rule blocks to define a rule_based segment.",
+			)
+		}
+	case statsig.SegmentTypeIDList:
+		if !rules.IsUnknown() && len(rules.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule"),
+				"Invalid Segment Configuration",
+				"Rule blocks can only be used with rule_based segments. Use the ids attribute to define an id_list segment.",
+			)
+		}
+	}
+}
+
+// Create builds a new segment, and then sets its rules or IDs depending on the segment type.
+func (r *SegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SegmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the segment
+	segment, err := r.client.CreateSegment(ctx, plan.toAPIRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create segment, got error: %s", err),
+		)
+		return
+	}
+
+	// Save the segment into the state before setting its members, so a failure does not leave an
+	// untracked segment behind.
+	state := SegmentResourceModel{Timeouts: plan.Timeouts}
+	state.Segment, diags = newSegmentFromAPI(ctx, segment, nil, nil, nil)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment created with Name: %s; and ID: %s", state.Name, state.ID))
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, segment.ID, plan.Segment, state.Segment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Segment, diags = r.read(ctx, segment, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Read fetches the segment from the API and updates the Terraform state with the segment attributes.
+func (r *SegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SegmentResourceModel
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the segment from the API
+	segment, err := r.client.GetSegment(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Segment %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Segment, diags = r.read(ctx, segment, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
+}
+
+// Update changes the attributes of the segment as specified in the Terraform plan.
+func (r *SegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SegmentResourceModel
+	var state SegmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update the segment
+	segment, err := r.client.UpdateSegment(ctx, state.ID.ValueString(), plan.toAPIRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Segment",
+			fmt.Sprintf("Unable to update segment, got error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateMembers(ctx, state.ID.ValueString(), plan.Segment, state.Segment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Segment, diags = r.read(ctx, segment, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+}
+
+func (r *SegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SegmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteSegment(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Segment",
+			"Unable to delete segment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing segment by its ID. The remaining attributes are populated by Read.
+func (r *SegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// read fetches the rules or IDs of the segment depending on its type, and maps them to the Terraform model
+// along with the segment. prior holds the rules of the plan or state the segment is read into.
+func (r *SegmentResource) read(ctx context.Context, segment *statsig.SegmentAPIRequest, prior []gates.Rule) (Segment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var err error
+
+	var conditions *statsig.SegmentConditionsAPIRequest
+	var idList *statsig.SegmentIDListAPIRequest
+
+	switch segment.Type {
+	case statsig.SegmentTypeRuleBased:
+		conditions, err = r.client.GetSegmentConditions(ctx, segment.ID)
+	case statsig.SegmentTypeIDList:
+		idList, err = r.client.GetSegmentIDList(ctx, segment.ID)
+	}
+	if err != nil {
+		diags.AddError("Client Error", err.Error())
+		return Segment{}, diags
+	}
+
+	state, d := newSegmentFromAPI(ctx, segment, conditions, idList, prior)
+	diags.Append(d...)
+
+	return state, diags
+}
+
+// updateMembers replaces the rules of a rule based segment, or adds and removes the IDs of an ID list
+// segment. Only the IDs that changed between the state and the plan are sent.
+func (r *SegmentResource) updateMembers(ctx context.Context, segmentID string, plan Segment, state Segment) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch plan.Type.ValueString() {
+	case statsig.SegmentTypeRuleBased:
+		rules, d := gates.RulesToAPI(ctx, plan.Rules)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := r.client.UpdateSegmentConditions(ctx, segmentID, statsig.SegmentConditionsAPIRequest{Rules: rules}); err != nil {
+			diags.AddError(
+				"Error Updating Segment Rules",
+				fmt.Sprintf("Unable to update the rules of segment, got error: %s", err),
+			)
+		}
+	case statsig.SegmentTypeIDList:
+		planIDs, d := common.StringSetElements(ctx, plan.IDs)
+		diags.Append(d...)
+		stateIDs, d := common.StringSetElements(ctx, state.IDs)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if removed := difference(stateIDs, planIDs); len(removed) > 0 {
+			if err := r.client.RemoveSegmentIDs(ctx, segmentID, removed); err != nil {
+				diags.AddError(
+					"Error Removing Segment IDs",
+					fmt.Sprintf("Unable to remove %d IDs from segment, got error: %s", len(removed), err),
+				)
+				return diags
+			}
+		}
+
+		if added := difference(planIDs, stateIDs); len(added) > 0 {
+			if err := r.client.AddSegmentIDs(ctx, segmentID, added); err != nil {
+				diags.AddError(
+					"Error Adding Segment IDs",
+					fmt.Sprintf("Unable to add %d IDs to segment, got error: %s", len(added), err),
+				)
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package segments
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+// recordingAPI records the IDs added to and removed from the segments.
+type recordingAPI struct {
+	*statsigfake.API
+	added   [][]string
+	removed [][]string
+}
+
+func (a *recordingAPI) AddSegmentIDs(ctx context.Context, segmentID string, ids []string) error {
+	a.added = append(a.added, ids)
+	return a.API.AddSegmentIDs(ctx, segmentID, ids)
+}
+
+func (a *recordingAPI) RemoveSegmentIDs(ctx context.Context, segmentID string, ids []string) error {
+	a.removed = append(a.removed, ids)
+	return a.API.RemoveSegmentIDs(ctx, segmentID, ids)
+}
+
+func TestSegmentResourceUpdateIDs(t *testing.T) {
+	ctx := context.Background()
+	api := &recordingAPI{API: statsigfake.New()}
+	if _, err := api.CreateSegment(ctx, statsig.SegmentAPIRequest{Name: "beta_users", Type: statsig.SegmentTypeIDList, IDType: "userID"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := api.API.AddSegmentIDs(ctx, "beta_users", []string{"user-1", "user-2", "user-3"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &SegmentResource{client: api}
+	schemas := commontest.NewResource(t, r)
+
+	segmentValue := func(ids ...string) tftypes.Value {
+		return schemas.Value(map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "beta_users"),
+			"name":        tftypes.NewValue(tftypes.String, "beta_users"),
+			"description": tftypes.NewValue(tftypes.String, ""),
+			"type":        tftypes.NewValue(tftypes.String, statsig.SegmentTypeIDList),
+			"id_type":     tftypes.NewValue(tftypes.String, "userID"),
+			"ids":         commontest.StringSetValue(ids...),
+			"rule":        tftypes.NewValue(schemas.Type().AttributeTypes["rule"], []tftypes.Value{}),
+		})
+	}
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemas.Schema, Raw: segmentValue("user-1", "user-2", "user-3")},
+		Plan:  tfsdk.Plan{Schema: schemas.Schema, Raw: segmentValue("user-2", "user-3", "user-4", "user-5")},
+	}
+	resp := &resource.UpdateResponse{
+		State:    tfsdk.State{Schema: schemas.Schema, Raw: req.State.Raw},
+		Identity: schemas.NullIdentity(),
+	}
+	r.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// Only the IDs that changed are sent.
+	if len(api.removed) != 1 || !slices.Equal(api.removed[0], []string{"user-1"}) {
+		t.Errorf("expected user-1 to be removed, got %v", api.removed)
+	}
+	if len(api.added) != 1 || !slices.Equal(api.added[0], []string{"user-4", "user-5"}) {
+		t.Errorf("expected user-4 and user-5 to be added, got %v", api.added)
+	}
+
+	idList, err := api.GetSegmentIDList(ctx, "beta_users")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	slices.Sort(idList.IDs)
+	if expected := []string{"user-2", "user-3", "user-4", "user-5"}; !slices.Equal(idList.IDs, expected) {
+		t.Errorf("expected the segment to contain %v, got %v", expected, idList.IDs)
+	}
+
+	var ids types.Set
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("ids"), &ids)...)
+	if resp.Diagnostics.HasError() || len(ids.Elements()) != 4 {
+		t.Errorf("expected the state to contain the 4 IDs, got %s: %v", ids, resp.Diagnostics)
+	}
+}
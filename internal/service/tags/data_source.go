@@ -23,7 +23,7 @@ func NewTagsDataSource() datasource.DataSource {
 
 // TagsDataSource defines the data source implementation.
 type TagsDataSource struct {
-	client *statsig.Client
+	client statsig.StatsigAPI
 }
 
 func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,12 +64,12 @@ func (d *TagsDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*statsig.Client)
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *statsig.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -88,7 +88,7 @@ func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	tags, err := d.client.GetTags(ctx)
+	tags, err := d.client.GetTags(ctx, statsig.ListOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Statsig Tags, got error: %s", err))
 		return
@@ -0,0 +1,76 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &TagListResource{}
+	_ list.ListResourceWithConfigure = &TagListResource{}
+)
+
+func NewTagListResource() list.ListResource {
+	return &TagListResource{}
+}
+
+// TagListResource lists the tags of the Statsig Project, so they can be imported with `terraform query`.
+type TagListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *TagListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *TagListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the tags in the Statsig Project.",
+	}
+}
+
+func (r *TagListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every tag of the project, up to the limit of the query.
+func (r *TagListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tags, err := r.client.GetTags(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, tags,
+		func(tag statsig.TagAPIRequest) (string, string) {
+			return tag.ID, tag.Name
+		},
+		func(ctx context.Context, tag statsig.TagAPIRequest) (any, diag.Diagnostics) {
+			return TagResourceModel{Tag: newTagFromAPI(&tag), Timeouts: common.NullTimeouts()}, nil
+		},
+	)
+}
@@ -1,7 +1,9 @@
 package tags
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 )
 
 // TagsDataSourceModel describes the data source data model.
@@ -9,9 +11,25 @@ type TagsDataSourceModel struct {
 	Tags []Tag `tfsdk:"tags"`
 }
 
+// TagResourceModel describes the resource data model. The attributes of the tag are shared with the data source.
+type TagResourceModel struct {
+	Tag
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type Tag struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	IsCore      types.Bool   `tfsdk:"is_core"`
 }
+
+// newTagFromAPI maps the API response model to the Terraform model.
+func newTagFromAPI(tag *statsig.TagAPIRequest) Tag {
+	return Tag{
+		ID:          types.StringValue(tag.ID),
+		Name:        types.StringValue(tag.Name),
+		Description: types.StringValue(tag.Description),
+		IsCore:      types.BoolValue(tag.IsCore),
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
 	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 )
 
@@ -19,6 +20,7 @@ import (
 var (
 	_ resource.Resource                = &TagResource{}
 	_ resource.ResourceWithImportState = &TagResource{}
+	_ resource.ResourceWithIdentity    = &TagResource{}
 	_ resource.ResourceWithConfigure   = &TagResource{}
 )
 
@@ -27,7 +29,7 @@ func NewTagResource() resource.Resource {
 }
 
 type TagResource struct {
-	client *statsig.Client
+	client statsig.StatsigAPI
 }
 
 func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,21 +65,29 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
+		},
 	}
 }
 
+func (r *TagResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
 func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*statsig.Client)
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *statsig.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -91,7 +101,7 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 // The ID of the created tag is saved into the Terraform state once the value is returned from the API.
 // Statsig references objects by Name, which is unique. The ID is not used for identifying unique objects.
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan Tag
+	var plan TagResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -100,6 +110,15 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Map the Terraform plan data to the API request model
 	apiReq := statsig.TagAPIRequest{
 		Name:        plan.Name.ValueString(),
@@ -118,7 +137,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Update the plan attributes with the tag attributes
-	plan = Tag{
+	plan.Tag = Tag{
 		ID:          types.StringValue(tag.ID),
 		Name:        types.StringValue(tag.Name),
 		Description: types.StringValue(tag.Description),
@@ -129,6 +148,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,7 +156,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 // Read fetches the tag from the API and updates the Terraform state with the tag attributes.
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state Tag
+	var state TagResourceModel
 
 	// Get the current state of the resource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -144,8 +164,22 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the tag from the API
 	tag, err := r.client.GetTag(ctx, state.Name.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Tag %s no longer exists, removing it from the state", state.Name))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -155,15 +189,11 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Update the state with the tag attributes
-	state = Tag{
-		ID:          types.StringValue(tag.ID),
-		Name:        types.StringValue(tag.Name),
-		Description: types.StringValue(tag.Description),
-		IsCore:      types.BoolValue(tag.IsCore),
-	}
+	state.Tag = newTagFromAPI(tag)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -174,8 +204,8 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 // The ID of the tag is not modified, as it is immutable in the Statsig API. Additionally, the IsCore attribute cannot
 // be modified via the API. This is a limitation of the Statsig API.
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan Tag
-	var state Tag
+	var plan TagResourceModel
+	var state TagResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -189,6 +219,15 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Map the Terraform plan data to the API request model
 	apiReq := statsig.TagAPIRequest{
 		ID:          plan.ID.ValueString(),
@@ -208,7 +247,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Update the plan attributes with the tag attributes
-	plan = Tag{
+	plan.Tag = Tag{
 		ID:          types.StringValue(tag.ID),
 		Name:        types.StringValue(tag.Name),
 		Description: types.StringValue(tag.Description),
@@ -219,6 +258,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -226,13 +266,22 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state Tag
+	var state TagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.client.DeleteTag(ctx, state.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Tag",
@@ -242,7 +291,62 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 }
 
-// TODO: Need to implement and test this functionality.
+// ImportState imports an existing tag by its name or its ID, given as the import ID or as the id of the identity.
+//
+// The API retrieves tags by name, so an ID is resolved through the list of tags. The full state is set from the
+// tag, so that Read can retrieve it by name.
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID := req.ID
+	if importID == "" {
+		var identity common.ResourceIdentity
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.ID.ValueString()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultTimeout)
+	defer cancel()
+
+	tag, err := r.client.GetTag(ctx, importID)
+	if statsig.IsNotFound(err) {
+		tag, err = r.findTagByID(ctx, importID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Tag",
+			fmt.Sprintf("Unable to import tag %q, got error: %s", importID, err),
+		)
+		return
+	}
+	if tag == nil {
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent Tag",
+			fmt.Sprintf("No tag has the name or the ID %q.", importID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), tag.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), tag.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), tag.Description)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("is_core"), tag.IsCore)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: types.StringValue(tag.ID)})...)
+}
+
+// findTagByID returns the tag with the ID from the list of tags, or nil when no tag has the ID.
+func (r *TagResource) findTagByID(ctx context.Context, id string) (*statsig.TagAPIRequest, error) {
+	tags, err := r.client.GetTags(ctx, statsig.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		if tag.ID == id {
+			return &tag, nil
+		}
+	}
+
+	return nil, nil
 }
@@ -0,0 +1,310 @@
+package target_apps
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &TargetAppAssignmentResource{}
+	_ resource.ResourceWithImportState = &TargetAppAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &TargetAppAssignmentResource{}
+)
+
+// The types of entity that can be assigned to a target app.
+const (
+	entityTypeGate          = "gate"
+	entityTypeDynamicConfig = "dynamic_config"
+	entityTypeExperiment    = "experiment"
+)
+
+// targetAppLocks serializes the changes made to each target app. The members of a target app are
+// replaced as a whole, so concurrent assignments to the same target app would otherwise overwrite
+// each other.
+var targetAppLocks sync.Map
+
+func lockTargetApp(id string) func() {
+	lock, _ := targetAppLocks.LoadOrStore(id, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+func NewTargetAppAssignmentResource() resource.Resource {
+	return &TargetAppAssignmentResource{}
+}
+
+type TargetAppAssignmentResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *TargetAppAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target_app_assignment"
+}
+
+func (r *TargetAppAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assign a gate, dynamic config or experiment to a target_app. Use this resource to manage the " +
+			"members of a target_app from the side of the entity, instead of the member attributes of `statsig_target_app`.",
+
+		Attributes: map[string]schema.Attribute{
+			"target_app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the target_app",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the entity: `gate`, `dynamic_config` or `experiment`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(entityTypeGate, entityTypeDynamicConfig, entityTypeExperiment),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the entity",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the assignment, in the format `target_app_id/entity_type/entity_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			// Every attribute of the assignment requires replacement, so there is nothing to time out on update.
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *TargetAppAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the entity to the members of the target_app.
+func (r *TargetAppAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TargetAppAssignment
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := r.updateMembers(ctx, plan, func(members []string) []string {
+		if slices.Contains(members, plan.EntityID.ValueString()) {
+			return members
+		}
+		return append(members, plan.EntityID.ValueString())
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to assign %s %q to target_app %q, got error: %s",
+				plan.EntityType.ValueString(), plan.EntityID.ValueString(), plan.TargetAppID.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strings.Join([]string{plan.TargetAppID.ValueString(), plan.EntityType.ValueString(), plan.EntityID.ValueString()}, "/"))
+
+	tflog.Trace(ctx, fmt.Sprintf("TargetApp assignment created with ID: %s", plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks the entity is still a member of the target_app, and removes the assignment from the state otherwise.
+func (r *TargetAppAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TargetAppAssignment
+
+	// Get the current state of the resource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the target_app from the API
+	target_app, err := r.client.GetTargetApp(ctx, state.TargetAppID.ValueString())
+	if statsig.IsNotFound(err) {
+		// Deleting the target_app also removes its members.
+		tflog.Warn(ctx, fmt.Sprintf("TargetApp assignment %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(*members(target_app, state.EntityType.ValueString()), state.EntityID.ValueString()) {
+		tflog.Warn(ctx, fmt.Sprintf("TargetApp assignment %s no longer exists, removing it from the state", state.ID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the timeouts into the state, as every other attribute of the assignment requires replacement.
+func (r *TargetAppAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TargetAppAssignment
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the entity from the members of the target_app.
+func (r *TargetAppAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TargetAppAssignment
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.updateMembers(ctx, state, func(members []string) []string {
+		return slices.DeleteFunc(members, func(member string) bool {
+			return member == state.EntityID.ValueString()
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting TargetApp Assignment",
+			"Unable to remove the entity from the target_app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing assignment by an ID in the format `target_app_id/entity_type/entity_id`.
+func (r *TargetAppAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier in the format target_app_id/entity_type/entity_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity_id"), parts[2])...)
+}
+
+// updateMembers applies the change to the members of the target_app that hold the type of the assigned entity.
+func (r *TargetAppAssignmentResource) updateMembers(ctx context.Context, assignment TargetAppAssignment, change func([]string) []string) error {
+	unlock := lockTargetApp(assignment.TargetAppID.ValueString())
+	defer unlock()
+
+	target_app, err := r.client.GetTargetApp(ctx, assignment.TargetAppID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	entities := members(target_app, assignment.EntityType.ValueString())
+	*entities = change(*entities)
+
+	_, err = r.client.UpdateTargetApp(ctx, assignment.TargetAppID.ValueString(), *target_app)
+	return err
+}
+
+// members returns the members of the target_app that hold the provided type of entity.
+func members(targetApp *statsig.TargetAppAPIRequest, entityType string) *[]string {
+	field := &targetApp.Gates
+	switch entityType {
+	case entityTypeDynamicConfig:
+		field = &targetApp.DynamicConfigs
+	case entityTypeExperiment:
+		field = &targetApp.Experiments
+	}
+
+	if *field == nil {
+		*field = &[]string{}
+	}
+	return *field
+}
@@ -0,0 +1,82 @@
+package target_apps
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+func TestTargetAppAssignmentResource(t *testing.T) {
+	ctx := context.Background()
+	api := statsigfake.New()
+	web, err := api.CreateTargetApp(ctx, statsig.TargetAppAPIRequest{Name: "web", Gates: &[]string{"existing_gate"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &TargetAppAssignmentResource{client: api}
+	schemas := commontest.NewResource(t, r)
+
+	plan := tfsdk.Plan{Schema: schemas.Schema, Raw: schemas.NullState().Raw}
+	diags := plan.Set(ctx, &TargetAppAssignment{
+		ID:          types.StringUnknown(),
+		TargetAppID: types.StringValue(web.ID),
+		EntityType:  types.StringValue(entityTypeGate),
+		EntityID:    types.StringValue("checkout"),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"delete": types.StringType,
+		})},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &resource.CreateResponse{State: schemas.NullState()}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var state TargetAppAssignment
+	createResp.State.Get(ctx, &state)
+	if expected := web.ID + "/gate/checkout"; state.ID.ValueString() != expected {
+		t.Errorf("expected the ID %s, got %s", expected, state.ID)
+	}
+
+	targetApp, _ := api.GetTargetApp(ctx, web.ID)
+	if !slices.Equal(*targetApp.Gates, []string{"existing_gate", "checkout"}) {
+		t.Errorf("expected the gate to be added to the existing members, got %v", *targetApp.Gates)
+	}
+
+	deleteResp := &resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	targetApp, _ = api.GetTargetApp(ctx, web.ID)
+	if !slices.Equal(*targetApp.Gates, []string{"existing_gate"}) {
+		t.Errorf("expected only the assigned gate to be removed, got %v", *targetApp.Gates)
+	}
+
+	// The assignment no longer exists, so reading it removes it from the state.
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Error("expected the assignment to be removed from the state")
+	}
+}
@@ -22,7 +22,7 @@ func NewTargetAppsDataSource() datasource.DataSource {
 
 // TargetAppsDataSource defines the data source implementation.
 type TargetAppsDataSource struct {
-	client *statsig.Client
+	client statsig.StatsigAPI
 }
 
 func (d *TargetAppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +47,18 @@ func (d *TargetAppsDataSource) Schema(ctx context.Context, req datasource.Schema
 						"description": schema.StringAttribute{
 							Computed: true,
 						},
+						"gates": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"dynamic_configs": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"experiments": schema.SetAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -60,12 +72,12 @@ func (d *TargetAppsDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*statsig.Client)
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *statsig.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -84,18 +96,16 @@ func (d *TargetAppsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	targetApps, err := d.client.GetTargetApps(ctx)
+	targetApps, err := d.client.GetTargetApps(ctx, statsig.ListOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Statsig TargetApps, got error: %s", err))
 		return
 	}
 
 	for _, targetApp := range targetApps {
-		state.TargetApps = append(state.TargetApps, TargetApp{
-			ID:          types.StringValue(targetApp.ID),
-			Name:        types.StringValue(targetApp.Name),
-			Description: types.StringValue(targetApp.Description),
-		})
+		model, diags := newTargetAppFromAPI(ctx, &targetApp)
+		resp.Diagnostics.Append(diags...)
+		state.TargetApps = append(state.TargetApps, model)
 	}
 
 	// Save data into Terraform state
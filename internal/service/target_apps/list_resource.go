@@ -0,0 +1,77 @@
+package target_apps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &TargetAppListResource{}
+	_ list.ListResourceWithConfigure = &TargetAppListResource{}
+)
+
+func NewTargetAppListResource() list.ListResource {
+	return &TargetAppListResource{}
+}
+
+// TargetAppListResource lists the target_apps of the Statsig Project, so they can be imported with `terraform query`.
+type TargetAppListResource struct {
+	client statsig.StatsigAPI
+}
+
+func (r *TargetAppListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target_app"
+}
+
+func (r *TargetAppListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "List the target_apps in the Statsig Project.",
+	}
+}
+
+func (r *TargetAppListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// List streams every target_app of the project, up to the limit of the query.
+func (r *TargetAppListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	targetApps, err := r.client.GetTargetApps(ctx, statsig.ListOptions{MaxItems: int(req.Limit)})
+	if err != nil {
+		stream.Results = common.ListError(err)
+		return
+	}
+
+	stream.Results = common.ListResults(ctx, req, targetApps,
+		func(targetApp statsig.TargetAppAPIRequest) (string, string) {
+			return targetApp.ID, targetApp.Name
+		},
+		func(ctx context.Context, targetApp statsig.TargetAppAPIRequest) (any, diag.Diagnostics) {
+			state, diags := newTargetAppFromAPI(ctx, &targetApp)
+			return TargetAppResourceModel{TargetApp: state, Timeouts: common.NullTimeouts()}, diags
+		},
+	)
+}
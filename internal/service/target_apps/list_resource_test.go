@@ -0,0 +1,60 @@
+package target_apps
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+func TestTargetAppListResource(t *testing.T) {
+	ctx := context.Background()
+	api := statsigfake.New()
+	for _, name := range []string{"web", "mobile"} {
+		if _, err := api.CreateTargetApp(ctx, statsig.TargetAppAPIRequest{Name: name, Description: "The " + name + " app"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	schemas := commontest.NewResource(t, &TargetAppResource{})
+
+	r := &TargetAppListResource{client: api}
+	stream := &list.ListResultsStream{}
+	r.List(ctx, list.ListRequest{
+		IncludeResource:        true,
+		ResourceSchema:         schemas.Schema,
+		ResourceIdentitySchema: schemas.IdentitySchema,
+	}, stream)
+
+	var names []string
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+
+		var identity common.ResourceIdentity
+		result.Identity.Get(ctx, &identity)
+		var state TargetAppResourceModel
+		if diags := result.Resource.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if identity.ID != state.ID || result.DisplayName != state.Name.ValueString() {
+			t.Errorf("expected the identity and display name to match the resource, got %s and %s for %+v", identity.ID, result.DisplayName, state.TargetApp)
+		}
+		if state.Description.ValueString() != "The "+state.Name.ValueString()+" app" {
+			t.Errorf("expected the full resource, got %+v", state.TargetApp)
+		}
+		names = append(names, result.DisplayName)
+	}
+
+	slices.Sort(names)
+	if len(names) != 2 || names[0] != "mobile" || names[1] != "web" {
+		t.Errorf("expected every target_app, got %v", names)
+	}
+}
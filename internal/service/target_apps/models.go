@@ -1,7 +1,15 @@
 package target_apps
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 )
 
 // TagsDataSourceModel describes the data source data model.
@@ -9,11 +17,107 @@ type TargetAppsDataSourceModel struct {
 	TargetApps []TargetApp `tfsdk:"target_apps"`
 }
 
+// TargetAppResourceModel describes the resource data model. The attributes of the target_app are shared with the
+// data source.
+type TargetAppResourceModel struct {
+	TargetApp
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type TargetApp struct {
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
 	Description    types.String `tfsdk:"description"`
-	Gates          types.List   `tfsdk:"gates"`
-	DynamicConfigs types.List   `tfsdk:"dynamic_configs"`
-	Experiments    types.List   `tfsdk:"experiments"`
+	Gates          types.Set    `tfsdk:"gates"`
+	DynamicConfigs types.Set    `tfsdk:"dynamic_configs"`
+	Experiments    types.Set    `tfsdk:"experiments"`
+}
+
+// TargetAppAssignment describes a single gate, dynamic config or experiment in a target app.
+type TargetAppAssignment struct {
+	ID          types.String   `tfsdk:"id"`
+	TargetAppID types.String   `tfsdk:"target_app_id"`
+	EntityType  types.String   `tfsdk:"entity_type"`
+	EntityID    types.String   `tfsdk:"entity_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toAPIRequest maps the Terraform model to the API request model.
+func (t *TargetApp) toAPIRequest(ctx context.Context) (statsig.TargetAppAPIRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiReq := statsig.TargetAppAPIRequest{
+		ID:          t.ID.ValueString(),
+		Name:        t.Name.ValueString(),
+		Description: t.Description.ValueString(),
+	}
+
+	var d diag.Diagnostics
+	apiReq.Gates, d = memberIDs(ctx, t.Gates)
+	diags.Append(d...)
+	apiReq.DynamicConfigs, d = memberIDs(ctx, t.DynamicConfigs)
+	diags.Append(d...)
+	apiReq.Experiments, d = memberIDs(ctx, t.Experiments)
+	diags.Append(d...)
+
+	return apiReq, diags
+}
+
+// withUnconfiguredMembers sets the members that are not in the configuration to null, so that toAPIRequest leaves
+// them out of the request and the members assigned elsewhere, such as by statsig_target_app_assignment, are kept.
+func (t *TargetApp) withUnconfiguredMembers(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for attribute, members := range map[string]*types.Set{
+		"gates":           &t.Gates,
+		"dynamic_configs": &t.DynamicConfigs,
+		"experiments":     &t.Experiments,
+	} {
+		var configured types.Set
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &configured)...)
+		if configured.IsNull() {
+			*members = types.SetNull(types.StringType)
+		}
+	}
+
+	return diags
+}
+
+// memberIDs returns the IDs of the members in the set, or nil when the set is null or unknown.
+func memberIDs(ctx context.Context, set types.Set) (*[]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	ids, diags := common.StringSetElements(ctx, set)
+	return &ids, diags
+}
+
+// newTargetAppFromAPI maps the API response model to the Terraform model.
+func newTargetAppFromAPI(ctx context.Context, targetApp *statsig.TargetAppAPIRequest) (TargetApp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	gates, d := common.StringSetValue(ctx, memberList(targetApp.Gates))
+	diags.Append(d...)
+	dynamicConfigs, d := common.StringSetValue(ctx, memberList(targetApp.DynamicConfigs))
+	diags.Append(d...)
+	experiments, d := common.StringSetValue(ctx, memberList(targetApp.Experiments))
+	diags.Append(d...)
+
+	return TargetApp{
+		ID:             types.StringValue(targetApp.ID),
+		Name:           types.StringValue(targetApp.Name),
+		Description:    types.StringValue(targetApp.Description),
+		Gates:          gates,
+		DynamicConfigs: dynamicConfigs,
+		Experiments:    experiments,
+	}, diags
+}
+
+// memberList returns the IDs of the members, which are empty when the API leaves them out.
+func memberList(ids *[]string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return *ids
 }
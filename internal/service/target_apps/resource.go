@@ -8,9 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
 	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 )
 
@@ -18,6 +20,7 @@ import (
 var (
 	_ resource.Resource                = &TargetAppResource{}
 	_ resource.ResourceWithImportState = &TargetAppResource{}
+	_ resource.ResourceWithIdentity    = &TargetAppResource{}
 	_ resource.ResourceWithConfigure   = &TargetAppResource{}
 )
 
@@ -26,7 +29,7 @@ func NewTargetAppResource() resource.Resource {
 }
 
 type TargetAppResource struct {
-	client *statsig.Client
+	client statsig.StatsigAPI
 }
 
 func (r *TargetAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,22 +59,62 @@ func (r *TargetAppResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			// The members of the target_app are only managed when set. Otherwise, the members are read from the API,
+			// so they can be managed with statsig_target_app_assignment or the target_apps attribute of each entity.
+			"gates": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the gates evaluated in the target_app. " +
+					"Do not combine with `statsig_target_app_assignment` resources for the same target_app.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dynamic_configs": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the dynamic configs evaluated in the target_app. " +
+					"Do not combine with `statsig_target_app_assignment` resources for the same target_app.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"experiments": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the experiments evaluated in the target_app. " +
+					"Do not combine with `statsig_target_app_assignment` resources for the same target_app.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": common.TimeoutsBlock(ctx),
 		},
 	}
 }
 
+func (r *TargetAppResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.IdentitySchema()
+}
+
 func (r *TargetAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*statsig.Client)
+	client, ok := req.ProviderData.(statsig.StatsigAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *statsig.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected statsig.StatsigAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -82,10 +125,10 @@ func (r *TargetAppResource) Configure(ctx context.Context, req resource.Configur
 
 // Create builds a new target_app with the provided attributes.
 //
-// The ID of the created target_app is saved into the Terraform state once the value is returned from the API.
-// Statsig references objects by Name, which is unique. The ID is not used for identifying unique objects.
+// The ID of the created target_app is saved into the Terraform state once the value is returned from the API, and
+// is used to reference the target_app afterwards.
 func (r *TargetAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan TargetApp
+	var plan TargetAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -94,10 +137,20 @@ func (r *TargetAppResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Map the Terraform plan data to the API request model
-	apiReq := statsig.TargetAppAPIRequest{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+	apiReq, diags := plan.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the target_app
@@ -111,16 +164,17 @@ func (r *TargetAppResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Update the plan attributes with the target_app attributes
-	plan = TargetApp{
-		ID:          types.StringValue(target_app.ID),
-		Name:        types.StringValue(target_app.Name),
-		Description: types.StringValue(target_app.Description),
+	plan.TargetApp, diags = newTargetAppFromAPI(ctx, target_app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("TargetApp created with Name: %s; and ID: %s", plan.Name, plan.ID))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -128,7 +182,7 @@ func (r *TargetAppResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read fetches the target_app from the API and updates the Terraform state with the target_app attributes.
 func (r *TargetAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state TargetApp
+	var state TargetAppResourceModel
 
 	// Get the current state of the resource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -136,8 +190,22 @@ func (r *TargetAppResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the target_app from the API
-	target_app, err := r.client.GetTargetApp(ctx, state.Name.ValueString())
+	target_app, err := r.client.GetTargetApp(ctx, state.ID.ValueString())
+	if statsig.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("TargetApp %s no longer exists, removing it from the state", state.Name))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -147,14 +215,15 @@ func (r *TargetAppResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Update the state with the target_app attributes
-	state = TargetApp{
-		ID:          types.StringValue(target_app.ID),
-		Name:        types.StringValue(target_app.Name),
-		Description: types.StringValue(target_app.Description),
+	state.TargetApp, diags = newTargetAppFromAPI(ctx, target_app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: state.ID})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -162,11 +231,11 @@ func (r *TargetAppResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update changes the attributes of the target_app as specified in the Terraform plan.
 //
-// The ID of the target_app is not modified, as it is immutable in the Statsig API. Additionally, the IsCore attribute cannot
-// be modified via the API. This is a limitation of the Statsig API.
+// The ID of the target_app is not modified, as it is immutable in the Statsig API. The target_app is referenced
+// by the ID in the current state, so that renaming the target_app updates the existing object.
 func (r *TargetAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan TargetApp
-	var state TargetApp
+	var plan TargetAppResourceModel
+	var state TargetAppResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -180,60 +249,145 @@ func (r *TargetAppResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Map the Terraform plan data to the API request model
-	// apiReq := statsig.TargetAppAPIRequest{
-	// 	ID:          plan.ID.ValueString(),
-	// 	Name:        plan.Name.ValueString(),
-	// 	Description: plan.Description.ValueString(),
-	// 	IsCore:      plan.IsCore.ValueBool(),
-	// }
-
-	// // Create the target_app
-	// target_app, err := r.client.UpdateTargetApp(ctx, state.Name.ValueString(), apiReq)
-	// if err != nil {
-	// 	resp.Diagnostics.AddError(
-	// 		"Error Updating TargetApp",
-	// 		fmt.Sprintf("Unable to update target_app, got error: %s", err),
-	// 	)
-	// 	return
-	// }
-
-	// // Update the plan attributes with the target_app attributes
-	// plan = TargetApp{
-	// 	ID:          types.StringValue(target_app.ID),
-	// 	Name:        types.StringValue(target_app.Name),
-	// 	Description: types.StringValue(target_app.Description),
-	// 	IsCore:      plan.IsCore, // IsCore is not modifiable via the API. Set the value to the current state.
-	// }
-
-	// tflog.Trace(ctx, fmt.Sprintf("TargetApp created with Name: %s; and ID: %s", plan.Name, plan.ID))
-
-	// // Save data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
+	updateTimeout, diags := plan.Timeouts.Update(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Map the Terraform plan data to the API request model. The members that are not configured keep their
+	// planned value from the state, but are not sent, so the members assigned elsewhere are not removed.
+	request := plan.TargetApp
+	resp.Diagnostics.Append(request.withUnconfiguredMembers(ctx, req.Config)...)
+	apiReq, diags := request.toAPIRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	apiReq.ID = state.ID.ValueString()
 
+	// Update the target_app
+	target_app, err := r.client.UpdateTargetApp(ctx, state.ID.ValueString(), apiReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating TargetApp",
+			fmt.Sprintf("Unable to update target_app, got error: %s", err),
+		)
+		return
+	}
+
+	// Update the plan attributes with the target_app attributes
+	plan.TargetApp, diags = newTargetAppFromAPI(ctx, target_app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("TargetApp updated with Name: %s; and ID: %s", plan.Name, plan.ID))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: plan.ID})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *TargetAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state TargetApp
+	var state TargetAppResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// if err := r.client.DeleteTargetApp(ctx, state.Name.ValueString()); err != nil {
-	// 	resp.Diagnostics.AddError(
-	// 		"Error Deleting TargetApp",
-	// 		"Unable to delete target_app, unexpected error: "+err.Error(),
-	// 	)
-	// 	return
-	// }
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, common.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.client.DeleteTargetApp(ctx, state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting TargetApp",
+			"Unable to delete target_app, unexpected error: "+err.Error(),
+		)
+		return
+	}
 }
 
-// TODO: Need to implement and test this functionality.
+// ImportState imports an existing target_app by its ID or its name, given as the import ID or as the id of the
+// identity.
+//
+// A name is resolved through the list of target_apps, as the API retrieves target_apps by ID. The full state is
+// set from the target_app, including its members.
 func (r *TargetAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importID := req.ID
+	if importID == "" {
+		var identity common.ResourceIdentity
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.ID.ValueString()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.DefaultTimeout)
+	defer cancel()
+
+	target_app, err := r.client.GetTargetApp(ctx, importID)
+	if statsig.IsNotFound(err) {
+		target_app, err = r.findTargetAppByName(ctx, importID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing TargetApp",
+			fmt.Sprintf("Unable to import target_app %q, got error: %s", importID, err),
+		)
+		return
+	}
+	if target_app == nil {
+		resp.Diagnostics.AddError(
+			"Cannot Import Non-Existent TargetApp",
+			fmt.Sprintf("No target_app has the ID or the name %q.", importID),
+		)
+		return
+	}
+
+	state, diags := newTargetAppFromAPI(ctx, target_app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), state.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), state.Name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), state.Description)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gates"), state.Gates)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dynamic_configs"), state.DynamicConfigs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("experiments"), state.Experiments)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, common.ResourceIdentity{ID: types.StringValue(target_app.ID)})...)
+}
+
+// findTargetAppByName returns the target_app with the name from the list of target_apps, or nil when no
+// target_app has the name.
+func (r *TargetAppResource) findTargetAppByName(ctx context.Context, name string) (*statsig.TargetAppAPIRequest, error) {
+	targetApps, err := r.client.GetTargetApps(ctx, statsig.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetApp := range targetApps {
+		if targetApp.Name == name {
+			return &targetApp, nil
+		}
+	}
+
+	return nil, nil
 }
@@ -0,0 +1,142 @@
+package target_apps
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/service/common/commontest"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/statsigfake"
+)
+
+func TestTargetAppResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	api := statsigfake.New()
+	if _, err := api.CreateTargetApp(ctx, statsig.TargetAppAPIRequest{ID: "3x7Fa", Name: "web", Description: "The web app", Gates: &[]string{"checkout"}}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := &TargetAppResource{client: api}
+	schemas := commontest.NewResource(t, r)
+
+	testCases := map[string]struct {
+		id  string
+		err bool
+	}{
+		"by id":     {id: "3x7Fa"},
+		"by name":   {id: "web"},
+		"not found": {id: "mobile", err: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State:    schemas.NullState(),
+				Identity: schemas.NullIdentity(),
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: testCase.id}, resp)
+			if resp.Diagnostics.HasError() != testCase.err {
+				t.Fatalf("expected error %t, got diagnostics: %v", testCase.err, resp.Diagnostics)
+			}
+			if testCase.err {
+				return
+			}
+
+			var state TargetAppResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if state.ID.ValueString() != "3x7Fa" || state.Name.ValueString() != "web" || state.Description.ValueString() != "The web app" {
+				t.Errorf("expected the state of the target_app, got %+v", state.TargetApp)
+			}
+			if len(state.Gates.Elements()) != 1 {
+				t.Errorf("expected the members of the target_app, got %s", state.Gates)
+			}
+
+			var identity common.ResourceIdentity
+			resp.Identity.Get(ctx, &identity)
+			if identity.ID.ValueString() != "3x7Fa" {
+				t.Errorf("expected the identity of the target_app, got %s", identity.ID)
+			}
+		})
+	}
+}
+
+func TestTargetAppResourceUpdateMembers(t *testing.T) {
+	ctx := context.Background()
+	setType := tftypes.Set{ElementType: tftypes.String}
+	existingGates := tftypes.NewValue(setType, []tftypes.Value{tftypes.NewValue(tftypes.String, "existing_gate")})
+
+	testCases := map[string]struct {
+		config   tftypes.Value
+		plan     tftypes.Value
+		expected []string
+	}{
+		// The gate assigned by statsig_target_app_assignment after the plan is kept.
+		"not configured": {config: tftypes.NewValue(setType, nil), plan: existingGates, expected: []string{"existing_gate", "checkout"}},
+		"configured":     {config: tftypes.NewValue(setType, []tftypes.Value{}), plan: tftypes.NewValue(setType, []tftypes.Value{}), expected: []string{}},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := statsigfake.New()
+			web, err := api.CreateTargetApp(ctx, statsig.TargetAppAPIRequest{Name: "web", Gates: &[]string{"existing_gate"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r := &TargetAppResource{client: api}
+			schemas := commontest.NewResource(t, r)
+
+			targetAppValue := func(id string, gates tftypes.Value) tftypes.Value {
+				return schemas.Value(map[string]tftypes.Value{
+					"id":          tftypes.NewValue(tftypes.String, id),
+					"name":        tftypes.NewValue(tftypes.String, "web"),
+					"description": tftypes.NewValue(tftypes.String, "The web app"),
+					"gates":       gates,
+				})
+			}
+
+			// Another gate is assigned to the target_app after the plan.
+			if _, err := api.UpdateTargetApp(ctx, web.ID, statsig.TargetAppAPIRequest{Name: "web", Gates: &[]string{"existing_gate", "checkout"}}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req := resource.UpdateRequest{
+				Config: tfsdk.Config{Schema: schemas.Schema, Raw: targetAppValue("", testCase.config)},
+				Plan:   tfsdk.Plan{Schema: schemas.Schema, Raw: targetAppValue(web.ID, testCase.plan)},
+				State:  tfsdk.State{Schema: schemas.Schema, Raw: targetAppValue(web.ID, existingGates)},
+			}
+			resp := &resource.UpdateResponse{
+				State:    tfsdk.State{Schema: schemas.Schema, Raw: req.State.Raw},
+				Identity: schemas.NullIdentity(),
+			}
+			r.Update(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			targetApp, err := api.GetTargetApp(ctx, web.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if targetApp.Description != "The web app" {
+				t.Errorf("expected the description to be updated, got %q", targetApp.Description)
+			}
+			if !slices.Equal(*targetApp.Gates, testCase.expected) {
+				t.Errorf("expected the gates %v, got %v", testCase.expected, *targetApp.Gates)
+			}
+
+			var state TargetAppResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() || len(state.Gates.Elements()) != len(testCase.expected) {
+				t.Errorf("expected the state to hold the gates of the target_app, got %s: %v", state.Gates, resp.Diagnostics)
+			}
+		})
+	}
+}
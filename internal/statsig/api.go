@@ -0,0 +1,82 @@
+package statsig
+
+import "context"
+
+// Ensure the Client satisfies the StatsigAPI interface.
+var _ StatsigAPI = &Client{}
+
+// StatsigAPI is the set of Statsig Console API operations used by the resources and data sources.
+// Client implements it on top of the generated client of the consoleapi package, while tests can provide a fake
+// implementation, such as the one of the statsigfake package.
+//
+// Errors returned for a response of the API are expected to be an *APIError, so that helpers such as
+// IsNotFound behave the same for every implementation.
+type StatsigAPI interface {
+	GetTags(ctx context.Context, opts ListOptions) ([]TagAPIRequest, error)
+	GetTag(ctx context.Context, tagName string) (*TagAPIRequest, error)
+	CreateTag(ctx context.Context, tag TagAPIRequest) (*TagAPIRequest, error)
+	UpdateTag(ctx context.Context, tagName string, planTag TagAPIRequest) (*TagAPIRequest, error)
+	DeleteTag(ctx context.Context, tagName string) error
+
+	GetTargetApps(ctx context.Context, opts ListOptions) ([]TargetAppAPIRequest, error)
+	GetTargetApp(ctx context.Context, targetAppID string) (*TargetAppAPIRequest, error)
+	CreateTargetApp(ctx context.Context, targetApp TargetAppAPIRequest) (*TargetAppAPIRequest, error)
+	UpdateTargetApp(ctx context.Context, targetAppID string, planTargetApp TargetAppAPIRequest) (*TargetAppAPIRequest, error)
+	DeleteTargetApp(ctx context.Context, targetAppID string) error
+
+	GetGates(ctx context.Context, opts ListOptions) ([]GateAPIRequest, error)
+	GetGate(ctx context.Context, gateID string) (*GateAPIRequest, error)
+	CreateGate(ctx context.Context, gate GateAPIRequest) (*GateAPIRequest, error)
+	UpdateGate(ctx context.Context, gateID string, planGate GateAPIRequest) (*GateAPIRequest, error)
+	DeleteGate(ctx context.Context, gateID string) error
+
+	GetDynamicConfigs(ctx context.Context, opts ListOptions) ([]DynamicConfigAPIRequest, error)
+	GetDynamicConfig(ctx context.Context, dynamicConfigID string) (*DynamicConfigAPIRequest, error)
+	CreateDynamicConfig(ctx context.Context, dynamicConfig DynamicConfigAPIRequest) (*DynamicConfigAPIRequest, error)
+	UpdateDynamicConfig(ctx context.Context, dynamicConfigID string, planDynamicConfig DynamicConfigAPIRequest) (*DynamicConfigAPIRequest, error)
+	DeleteDynamicConfig(ctx context.Context, dynamicConfigID string) error
+
+	GetExperiments(ctx context.Context, opts ListOptions) ([]ExperimentAPIRequest, error)
+	GetExperiment(ctx context.Context, experimentID string) (*ExperimentAPIRequest, error)
+	CreateExperiment(ctx context.Context, experiment ExperimentAPIRequest) (*ExperimentAPIRequest, error)
+	UpdateExperiment(ctx context.Context, experimentID string, planExperiment ExperimentAPIRequest) (*ExperimentAPIRequest, error)
+	DeleteExperiment(ctx context.Context, experimentID string) error
+	StartExperiment(ctx context.Context, experimentID string) error
+	MakeExperimentDecision(ctx context.Context, experimentID string, decision ExperimentDecisionAPIRequest) error
+	AbandonExperiment(ctx context.Context, experimentID string) error
+
+	GetLayers(ctx context.Context, opts ListOptions) ([]LayerAPIRequest, error)
+	GetLayer(ctx context.Context, layerID string) (*LayerAPIRequest, error)
+	CreateLayer(ctx context.Context, layer LayerAPIRequest) (*LayerAPIRequest, error)
+	UpdateLayer(ctx context.Context, layerID string, planLayer LayerAPIRequest) (*LayerAPIRequest, error)
+	DeleteLayer(ctx context.Context, layerID string) error
+
+	GetSegments(ctx context.Context, opts ListOptions) ([]SegmentAPIRequest, error)
+	GetSegment(ctx context.Context, segmentID string) (*SegmentAPIRequest, error)
+	CreateSegment(ctx context.Context, segment SegmentAPIRequest) (*SegmentAPIRequest, error)
+	UpdateSegment(ctx context.Context, segmentID string, planSegment SegmentAPIRequest) (*SegmentAPIRequest, error)
+	DeleteSegment(ctx context.Context, segmentID string) error
+	GetSegmentConditions(ctx context.Context, segmentID string) (*SegmentConditionsAPIRequest, error)
+	UpdateSegmentConditions(ctx context.Context, segmentID string, conditions SegmentConditionsAPIRequest) error
+	GetSegmentIDList(ctx context.Context, segmentID string) (*SegmentIDListAPIRequest, error)
+	AddSegmentIDs(ctx context.Context, segmentID string, ids []string) error
+	RemoveSegmentIDs(ctx context.Context, segmentID string, ids []string) error
+
+	GetHoldouts(ctx context.Context, opts ListOptions) ([]HoldoutAPIRequest, error)
+	GetHoldout(ctx context.Context, holdoutID string) (*HoldoutAPIRequest, error)
+	CreateHoldout(ctx context.Context, holdout HoldoutAPIRequest) (*HoldoutAPIRequest, error)
+	UpdateHoldout(ctx context.Context, holdoutID string, planHoldout HoldoutAPIRequest) (*HoldoutAPIRequest, error)
+	DeleteHoldout(ctx context.Context, holdoutID string) error
+
+	GetMetrics(ctx context.Context, opts ListOptions) ([]MetricAPIRequest, error)
+	GetMetric(ctx context.Context, metricID string) (*MetricAPIRequest, error)
+	CreateMetric(ctx context.Context, metric MetricAPIRequest) (*MetricAPIRequest, error)
+	UpdateMetric(ctx context.Context, metricID string, planMetric MetricAPIRequest) (*MetricAPIRequest, error)
+	DeleteMetric(ctx context.Context, metricID string) error
+
+	GetMetricSources(ctx context.Context, opts ListOptions) ([]MetricSourceAPIRequest, error)
+	GetMetricSource(ctx context.Context, name string) (*MetricSourceAPIRequest, error)
+	CreateMetricSource(ctx context.Context, source MetricSourceAPIRequest) (*MetricSourceAPIRequest, error)
+	UpdateMetricSource(ctx context.Context, name string, planSource MetricSourceAPIRequest) (*MetricSourceAPIRequest, error)
+	DeleteMetricSource(ctx context.Context, name string) error
+}
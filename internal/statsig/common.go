@@ -1,11 +1,60 @@
 package statsig
 
-type APIResponse[T any] struct {
-	Message string `json:"message"`
-	Data    T      `json:"data"`
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// defaultPageSize is the number of items requested per page when listing objects.
+const defaultPageSize = 100
+
+// APIPagination is the pagination metadata included in list responses. NextPage is empty on the last page.
+type APIPagination = consoleapi.Pagination
+
+// ListOptions configures how many objects a list call retrieves.
+type ListOptions struct {
+	// PageSize is the number of items requested per page. Defaults to 100.
+	PageSize int
+	// MaxItems caps the total number of items returned. Every page is followed when zero.
+	MaxItems int
 }
 
-type APIListResponse[T any] struct {
-	Message string `json:"message"`
-	Data    []T    `json:"data"`
+// listPage retrieves a page of a list endpoint through the generated client, along with its pagination metadata.
+type listPage[T any] func(ctx context.Context, page int, limit int) ([]T, APIPagination, error)
+
+// listAll retrieves every page of the list endpoint with the list function, and returns the items of all pages in
+// order. The name of the endpoint is only logged.
+//
+// Pages are followed until the pagination metadata reports there is no next page, an empty page is returned,
+// or MaxItems items have been retrieved.
+func listAll[T any](ctx context.Context, endpoint string, opts ListOptions, list listPage[T]) ([]T, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if opts.MaxItems > 0 && opts.MaxItems < pageSize {
+		pageSize = opts.MaxItems
+	}
+
+	var items []T
+	for page := 1; ; page++ {
+		data, pagination, err := list(ctx, page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, data...)
+		if opts.MaxItems > 0 && len(items) >= opts.MaxItems {
+			return items[:opts.MaxItems], nil
+		}
+
+		if len(data) == 0 || pagination.NextPage == "" ||
+			(pagination.TotalItems > 0 && len(items) >= pagination.TotalItems) {
+			tflog.Trace(ctx, fmt.Sprintf("Listed %d items from %s in %d pages", len(items), endpoint, page))
+			return items, nil
+		}
+	}
 }
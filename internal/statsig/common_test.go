@@ -0,0 +1,83 @@
+package statsig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// newPagedServer serves the provided number of tags, split into pages of the requested limit.
+func newPagedServer(t *testing.T, total int, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		response := consoleapi.TagListResponse{
+			Data:       []TagAPIRequest{},
+			Pagination: APIPagination{ItemsPerPage: limit, PageNumber: page, TotalItems: total},
+		}
+		for i := (page - 1) * limit; i < min(page*limit, total); i++ {
+			response.Data = append(response.Data, TagAPIRequest{ID: strconv.Itoa(i), Name: fmt.Sprintf("tag-%d", i)})
+		}
+		if page*limit < total {
+			response.Pagination.NextPage = fmt.Sprintf("/console/v1/tags?page=%d&limit=%d", page+1, limit)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Errorf("unable to encode response: %s", err)
+		}
+	}))
+}
+
+func TestListAll(t *testing.T) {
+	testCases := []struct {
+		name             string
+		total            int
+		opts             ListOptions
+		expectedItems    int
+		expectedRequests int
+	}{
+		{name: "single page", total: 10, expectedItems: 10, expectedRequests: 1},
+		{name: "every page", total: 250, expectedItems: 250, expectedRequests: 3},
+		{name: "exact pages", total: 200, expectedItems: 200, expectedRequests: 2},
+		{name: "page size", total: 25, opts: ListOptions{PageSize: 10}, expectedItems: 25, expectedRequests: 3},
+		{name: "max items", total: 250, opts: ListOptions{MaxItems: 150}, expectedItems: 150, expectedRequests: 2},
+		{name: "max items below page size", total: 250, opts: ListOptions{MaxItems: 5}, expectedItems: 5, expectedRequests: 1},
+		{name: "empty", total: 0, expectedItems: 0, expectedRequests: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			requests := 0
+			server := newPagedServer(t, testCase.total, &requests)
+			defer server.Close()
+
+			client, _ := NewAPIClient(context.Background(), "console-test")
+			client.HostURL = server.URL
+
+			tags, err := client.GetTags(context.Background(), testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(tags) != testCase.expectedItems {
+				t.Errorf("expected %d items, got %d", testCase.expectedItems, len(tags))
+			}
+			if requests != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, requests)
+			}
+			for i, tag := range tags {
+				if tag.ID != strconv.Itoa(i) {
+					t.Fatalf("expected item %d to have ID %d, got %s", i, i, tag.ID)
+				}
+			}
+		})
+	}
+}
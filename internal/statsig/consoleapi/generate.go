@@ -0,0 +1,7 @@
+// Package consoleapi is the client of the Statsig Console API, generated from openapi.yaml by oapi-codegen.
+//
+// The specification only covers the endpoints used by the provider. New endpoints are added to openapi.yaml, then
+// the client is generated again with go generate.
+package consoleapi
+
+//go:generate go tool oapi-codegen -config config.yaml openapi.yaml
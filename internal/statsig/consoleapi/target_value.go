@@ -0,0 +1,46 @@
+package consoleapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// TargetValue is the target value of a rule condition.
+//
+// The API accepts and returns either a single value or a list of values, which may be strings, numbers or
+// booleans depending on the condition type. The provider always represents them as a list of strings.
+type TargetValue []string
+
+func (t *TargetValue) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch value := raw.(type) {
+	case nil:
+		*t = nil
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			values = append(values, targetValueString(v))
+		}
+		*t = values
+	default:
+		*t = []string{targetValueString(value)}
+	}
+
+	return nil
+}
+
+func targetValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,101 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+type DynamicConfigAPIRequest = consoleapi.DynamicConfig
+
+// DynamicConfigRuleAPIRequest is a targeting rule of a dynamic config. It holds the fields of the common rule, along
+// with the JSON value returned to the users that pass the rule.
+type DynamicConfigRuleAPIRequest = consoleapi.DynamicConfigRule
+
+// GetDynamicConfigs retrieves every dynamic config of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetDynamicConfigs(ctx context.Context, opts ListOptions) ([]DynamicConfigAPIRequest, error) {
+	return listAll(ctx, "dynamic_configs", opts, func(ctx context.Context, page int, limit int) ([]DynamicConfigAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListDynamicConfigsWithResponse(ctx, &consoleapi.ListDynamicConfigsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		dynamicConfigs, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return dynamicConfigs.Data, dynamicConfigs.Pagination, nil
+	})
+}
+
+// GetDynamicConfig retrieves a dynamic config by its ID from the Statsig API.
+func (c *Client) GetDynamicConfig(ctx context.Context, dynamicConfigID string) (*DynamicConfigAPIRequest, error) {
+	res, err := c.GeneratedClient().GetDynamicConfigWithResponse(ctx, dynamicConfigID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting dynamic config: %s", err))
+		return nil, err
+	}
+
+	dynamicConfig, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading dynamic config: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config retrieved with Name: %s; and ID: %s", dynamicConfig.Data.Name, dynamicConfig.Data.ID))
+	return &dynamicConfig.Data, nil
+}
+
+func (c *Client) CreateDynamicConfig(ctx context.Context, dynamicConfig DynamicConfigAPIRequest) (*DynamicConfigAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateDynamicConfigWithResponse(ctx, dynamicConfig)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating dynamic config: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create dynamic config response: %s", res.Body))
+	createdDynamicConfig, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading dynamic config: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config created with ID: %s", createdDynamicConfig.Data.ID))
+
+	return &createdDynamicConfig.Data, nil
+}
+
+func (c *Client) UpdateDynamicConfig(ctx context.Context, dynamicConfigID string, planDynamicConfig DynamicConfigAPIRequest) (*DynamicConfigAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateDynamicConfigWithResponse(ctx, dynamicConfigID, planDynamicConfig)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating dynamic config '%s': %s", dynamicConfigID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update dynamic config response: %s", res.Body))
+	updatedDynamicConfig, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading dynamic config: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config updated with ID: %s", updatedDynamicConfig.Data.ID))
+
+	return &updatedDynamicConfig.Data, nil
+}
+
+func (c *Client) DeleteDynamicConfig(ctx context.Context, dynamicConfigID string) error {
+	_, err := c.GeneratedClient().DeleteDynamicConfigWithResponse(ctx, dynamicConfigID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting dynamic config: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Dynamic config deleted with ID: %s", dynamicConfigID))
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package statsig
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when the Statsig API responds to a request with a non-2xx status code. The message is the
+// reason given by the API, or the raw response body when the body is not a JSON error response.
+//
+// Use errors.As to inspect the status code, or the IsNotFound, IsConflict and IsRateLimited helpers.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Method     string
+	URL        string
+}
+
+// Error describes the failed request along with the reason given by the API.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s returned status %d: %s", e.Method, e.URL, e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError for an object that does not exist.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError for a request that conflicts with the current state of an object.
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is an APIError for a request rejected by the rate limits of the API.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
@@ -0,0 +1,68 @@
+package statsig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoRequestAPIError(t *testing.T) {
+	testCases := map[string]struct {
+		statusCode      int
+		body            string
+		expectedMessage string
+	}{
+		"json error":      {statusCode: 404, body: `{"status": 404, "message": "Gate not found"}`, expectedMessage: "Gate not found"},
+		"raw body":        {statusCode: 502, body: "<html>Bad Gateway</html>\n", expectedMessage: "<html>Bad Gateway</html>"},
+		"empty message":   {statusCode: 409, body: `{}`, expectedMessage: "Conflict"},
+		"empty body":      {statusCode: 400, body: ``, expectedMessage: "Bad Request"},
+		"unauthorized":    {statusCode: 401, body: `{"message": "Invalid key"}`, expectedMessage: "Unauthorized request. Please check your API key."},
+		"rate limited":    {statusCode: 429, body: `{"message": "Too many requests"}`, expectedMessage: "Too many requests"},
+		"status mismatch": {statusCode: 400, body: `{"status": 500, "message": "Invalid rule"}`, expectedMessage: "Invalid rule"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(testCase.statusCode)
+				_, _ = w.Write([]byte(testCase.body))
+			}))
+			defer server.Close()
+
+			client, _ := NewAPIClient(context.Background(), "console-test")
+			client.HostURL = server.URL
+			client.Retry.MaxRetries = 0
+
+			err := client.DeleteGate(context.Background(), "test")
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an *APIError, got: %v", err)
+			}
+			expected := APIError{StatusCode: testCase.statusCode, Message: testCase.expectedMessage, Method: "DELETE", URL: server.URL + "/gates/test"}
+			if *apiErr != expected {
+				t.Errorf("expected %+v, got %+v", expected, *apiErr)
+			}
+		})
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	notFound := fmt.Errorf("unable to read gate: %w", &APIError{StatusCode: http.StatusNotFound})
+	conflict := &APIError{StatusCode: http.StatusConflict}
+	rateLimited := &APIError{StatusCode: http.StatusTooManyRequests}
+	other := errors.New("connection refused")
+
+	if !IsNotFound(notFound) || IsNotFound(conflict) || IsNotFound(other) || IsNotFound(nil) {
+		t.Error("unexpected IsNotFound result")
+	}
+	if !IsConflict(conflict) || IsConflict(notFound) || IsConflict(other) {
+		t.Error("unexpected IsConflict result")
+	}
+	if !IsRateLimited(rateLimited) || IsRateLimited(conflict) || IsRateLimited(other) {
+		t.Error("unexpected IsRateLimited result")
+	}
+}
@@ -0,0 +1,151 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// The statuses an experiment moves through. A new experiment starts in setup, and can only move forward.
+const (
+	ExperimentStatusSetup        = "setup"
+	ExperimentStatusActive       = "active"
+	ExperimentStatusDecisionMade = "decision_made"
+	ExperimentStatusAbandoned    = "abandoned"
+)
+
+type ExperimentAPIRequest = consoleapi.Experiment
+
+type ExperimentMetricAPIRequest = consoleapi.ExperimentMetric
+
+type ExperimentGroupAPIRequest = consoleapi.ExperimentGroup
+
+// ExperimentDecisionAPIRequest is the request body used to ship a group of an experiment.
+type ExperimentDecisionAPIRequest = consoleapi.ExperimentDecision
+
+// GetExperiments retrieves every experiment of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetExperiments(ctx context.Context, opts ListOptions) ([]ExperimentAPIRequest, error) {
+	return listAll(ctx, "experiments", opts, func(ctx context.Context, page int, limit int) ([]ExperimentAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListExperimentsWithResponse(ctx, &consoleapi.ListExperimentsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		experiments, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return experiments.Data, experiments.Pagination, nil
+	})
+}
+
+// GetExperiment retrieves an experiment by its ID from the Statsig API.
+func (c *Client) GetExperiment(ctx context.Context, experimentID string) (*ExperimentAPIRequest, error) {
+	res, err := c.GeneratedClient().GetExperimentWithResponse(ctx, experimentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting experiment: %s", err))
+		return nil, err
+	}
+
+	experiment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading experiment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment retrieved with Name: %s; and ID: %s", experiment.Data.Name, experiment.Data.ID))
+	return &experiment.Data, nil
+}
+
+func (c *Client) CreateExperiment(ctx context.Context, experiment ExperimentAPIRequest) (*ExperimentAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateExperimentWithResponse(ctx, experiment)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating experiment: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create experiment response: %s", res.Body))
+	createdExperiment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading experiment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment created with ID: %s", createdExperiment.Data.ID))
+
+	return &createdExperiment.Data, nil
+}
+
+func (c *Client) UpdateExperiment(ctx context.Context, experimentID string, planExperiment ExperimentAPIRequest) (*ExperimentAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateExperimentWithResponse(ctx, experimentID, planExperiment)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating experiment '%s': %s", experimentID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update experiment response: %s", res.Body))
+	updatedExperiment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading experiment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment updated with ID: %s", updatedExperiment.Data.ID))
+
+	return &updatedExperiment.Data, nil
+}
+
+func (c *Client) DeleteExperiment(ctx context.Context, experimentID string) error {
+	_, err := c.GeneratedClient().DeleteExperimentWithResponse(ctx, experimentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting experiment: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment deleted with ID: %s", experimentID))
+
+	return nil
+}
+
+// StartExperiment moves an experiment from setup to active, which starts allocating users to its groups.
+func (c *Client) StartExperiment(ctx context.Context, experimentID string) error {
+	_, err := c.GeneratedClient().StartExperimentWithResponse(ctx, experimentID, consoleapi.Empty{})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error starting experiment '%s': %s", experimentID, err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment started with ID: %s", experimentID))
+
+	return nil
+}
+
+// MakeExperimentDecision ships the provided group of an active experiment to every user.
+func (c *Client) MakeExperimentDecision(ctx context.Context, experimentID string, decision ExperimentDecisionAPIRequest) error {
+	_, err := c.GeneratedClient().MakeExperimentDecisionWithResponse(ctx, experimentID, decision)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error making decision for experiment '%s': %s", experimentID, err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment decision made with ID: %s; and group: %s", experimentID, decision.ID))
+
+	return nil
+}
+
+// AbandonExperiment stops an experiment without shipping any of its groups.
+func (c *Client) AbandonExperiment(ctx context.Context, experimentID string) error {
+	_, err := c.GeneratedClient().AbandonExperimentWithResponse(ctx, experimentID, consoleapi.Empty{})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error abandoning experiment '%s': %s", experimentID, err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Experiment abandoned with ID: %s", experimentID))
+
+	return nil
+}
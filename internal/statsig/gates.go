@@ -0,0 +1,101 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+type GateAPIRequest = consoleapi.Gate
+
+// GateOwner is the owner of a gate. Only the OwnerID is required when setting the owner, the remaining
+// fields are populated by the API.
+type GateOwner = consoleapi.GateOwner
+
+// GetGates retrieves every gate of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetGates(ctx context.Context, opts ListOptions) ([]GateAPIRequest, error) {
+	return listAll(ctx, "gates", opts, func(ctx context.Context, page int, limit int) ([]GateAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListGatesWithResponse(ctx, &consoleapi.ListGatesParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		gates, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return gates.Data, gates.Pagination, nil
+	})
+}
+
+// GetGate retrieves a gate by its ID from the Statsig API.
+func (c *Client) GetGate(ctx context.Context, gateID string) (*GateAPIRequest, error) {
+	res, err := c.GeneratedClient().GetGateWithResponse(ctx, gateID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting gate: %s", err))
+		return nil, err
+	}
+
+	gate, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading gate: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate retrieved with Name: %s; and ID: %s", gate.Data.Name, gate.Data.ID))
+	return &gate.Data, nil
+}
+
+func (c *Client) CreateGate(ctx context.Context, gate GateAPIRequest) (*GateAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateGateWithResponse(ctx, gate)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating gate: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create gate response: %s", res.Body))
+	createdGate, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading gate: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate created with ID: %s", createdGate.Data.ID))
+
+	return &createdGate.Data, nil
+}
+
+func (c *Client) UpdateGate(ctx context.Context, gateID string, planGate GateAPIRequest) (*GateAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateGateWithResponse(ctx, gateID, planGate)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating gate '%s': %s", gateID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update gate response: %s", res.Body))
+	updatedGate, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading gate: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate updated with ID: %s", updatedGate.Data.ID))
+
+	return &updatedGate.Data, nil
+}
+
+func (c *Client) DeleteGate(ctx context.Context, gateID string) error {
+	_, err := c.GeneratedClient().DeleteGateWithResponse(ctx, gateID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting gate: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Gate deleted with ID: %s", gateID))
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// HoldoutAPIRequest holds a holdout and the gates, experiments and layers it applies to. A global
+// holdout applies to every gate and experiment in the project, so it has no explicit targets.
+type HoldoutAPIRequest = consoleapi.Holdout
+
+// GetHoldouts retrieves every holdout of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetHoldouts(ctx context.Context, opts ListOptions) ([]HoldoutAPIRequest, error) {
+	return listAll(ctx, "holdouts", opts, func(ctx context.Context, page int, limit int) ([]HoldoutAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListHoldoutsWithResponse(ctx, &consoleapi.ListHoldoutsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		holdouts, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return holdouts.Data, holdouts.Pagination, nil
+	})
+}
+
+// GetHoldout retrieves a holdout by its ID from the Statsig API.
+func (c *Client) GetHoldout(ctx context.Context, holdoutID string) (*HoldoutAPIRequest, error) {
+	res, err := c.GeneratedClient().GetHoldoutWithResponse(ctx, holdoutID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting holdout: %s", err))
+		return nil, err
+	}
+
+	holdout, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading holdout: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout retrieved with Name: %s; and ID: %s", holdout.Data.Name, holdout.Data.ID))
+	return &holdout.Data, nil
+}
+
+func (c *Client) CreateHoldout(ctx context.Context, holdout HoldoutAPIRequest) (*HoldoutAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateHoldoutWithResponse(ctx, holdout)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating holdout: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create holdout response: %s", res.Body))
+	createdHoldout, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading holdout: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout created with ID: %s", createdHoldout.Data.ID))
+
+	return &createdHoldout.Data, nil
+}
+
+// UpdateHoldout changes the holdout in place. The gates, experiments and layers in the request replace
+// the current targets, which attaches and detaches them without recreating the holdout.
+func (c *Client) UpdateHoldout(ctx context.Context, holdoutID string, planHoldout HoldoutAPIRequest) (*HoldoutAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateHoldoutWithResponse(ctx, holdoutID, planHoldout)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating holdout '%s': %s", holdoutID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update holdout response: %s", res.Body))
+	updatedHoldout, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading holdout: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout updated with ID: %s", updatedHoldout.Data.ID))
+
+	return &updatedHoldout.Data, nil
+}
+
+func (c *Client) DeleteHoldout(ctx context.Context, holdoutID string) error {
+	_, err := c.GeneratedClient().DeleteHoldoutWithResponse(ctx, holdoutID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting holdout: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Holdout deleted with ID: %s", holdoutID))
+
+	return nil
+}
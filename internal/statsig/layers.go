@@ -0,0 +1,101 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+type LayerAPIRequest = consoleapi.Layer
+
+type LayerParameterAPIRequest = consoleapi.LayerParameter
+
+// GetLayers retrieves every layer of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetLayers(ctx context.Context, opts ListOptions) ([]LayerAPIRequest, error) {
+	return listAll(ctx, "layers", opts, func(ctx context.Context, page int, limit int) ([]LayerAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListLayersWithResponse(ctx, &consoleapi.ListLayersParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		layers, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return layers.Data, layers.Pagination, nil
+	})
+}
+
+// GetLayer retrieves a layer by its ID from the Statsig API.
+func (c *Client) GetLayer(ctx context.Context, layerID string) (*LayerAPIRequest, error) {
+	res, err := c.GeneratedClient().GetLayerWithResponse(ctx, layerID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting layer: %s", err))
+		return nil, err
+	}
+
+	layer, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading layer: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer retrieved with Name: %s; and ID: %s", layer.Data.Name, layer.Data.ID))
+	return &layer.Data, nil
+}
+
+func (c *Client) CreateLayer(ctx context.Context, layer LayerAPIRequest) (*LayerAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateLayerWithResponse(ctx, layer)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating layer: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create layer response: %s", res.Body))
+	createdLayer, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading layer: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer created with ID: %s", createdLayer.Data.ID))
+
+	return &createdLayer.Data, nil
+}
+
+func (c *Client) UpdateLayer(ctx context.Context, layerID string, planLayer LayerAPIRequest) (*LayerAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateLayerWithResponse(ctx, layerID, planLayer)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating layer '%s': %s", layerID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update layer response: %s", res.Body))
+	updatedLayer, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading layer: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer updated with ID: %s", updatedLayer.Data.ID))
+
+	return &updatedLayer.Data, nil
+}
+
+// DeleteLayer deletes a layer by its ID. The API refuses to delete a layer that still contains
+// experiments, in which case the returned error is an *APIError explaining why.
+func (c *Client) DeleteLayer(ctx context.Context, layerID string) error {
+	_, err := c.GeneratedClient().DeleteLayerWithResponse(ctx, layerID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting layer: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Layer deleted with ID: %s", layerID))
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package statsig
+
+import (
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultRequestsPerSecond is the rate requests are sent at when the provider does not configure one.
+const DefaultRequestsPerSecond = 10
+
+// NewLimiter returns a token bucket limiter that allows the provided number of requests per second. The bucket
+// holds a second worth of requests, so short bursts are sent immediately. A rate of zero or less disables
+// the limiter, in which case nil is returned.
+func NewLimiter(requestsPerSecond float64) *rate.Limiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	burst := max(1, int(math.Ceil(requestsPerSecond)))
+	return rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+}
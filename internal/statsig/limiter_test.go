@@ -0,0 +1,55 @@
+package statsig
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewLimiter(t *testing.T) {
+	if limiter := NewLimiter(0); limiter != nil {
+		t.Errorf("expected no limiter for a rate of 0, got: %v", limiter)
+	}
+
+	limiter := NewLimiter(2.5)
+	if limiter.Limit() != 2.5 || limiter.Burst() != 3 {
+		t.Errorf("expected a limit of 2.5 and a burst of 3, got %v and %d", limiter.Limit(), limiter.Burst())
+	}
+
+	if limiter := NewLimiter(0.5); limiter.Burst() != 1 {
+		t.Errorf("expected a burst of 1, got %d", limiter.Burst())
+	}
+}
+
+func TestDoRequestLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL
+	client.Limiter = NewLimiter(20)
+
+	// The first 20 requests use the burst, and the next 10 are queued for half a second.
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetGate(context.Background(), "test"); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected the requests to be queued for at least 400ms, took %s", elapsed)
+	}
+}
@@ -0,0 +1,102 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// MetricSourceAPIRequest holds a warehouse native metric source. Metric sources are identified by their
+// name, and read their rows from the warehouse with a SQL query.
+type MetricSourceAPIRequest = consoleapi.MetricSource
+
+// MetricSourceIDTypeAPIRequest maps a unit ID type to the column of the query that holds it.
+type MetricSourceIDTypeAPIRequest = consoleapi.MetricSourceIDType
+
+// GetMetricSources retrieves every metric source of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetMetricSources(ctx context.Context, opts ListOptions) ([]MetricSourceAPIRequest, error) {
+	return listAll(ctx, "metrics/metric_source/list", opts, func(ctx context.Context, page int, limit int) ([]MetricSourceAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListMetricSourcesWithResponse(ctx, &consoleapi.ListMetricSourcesParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		metricSources, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return metricSources.Data, metricSources.Pagination, nil
+	})
+}
+
+// GetMetricSource retrieves a metric source by its name from the Statsig API.
+func (c *Client) GetMetricSource(ctx context.Context, name string) (*MetricSourceAPIRequest, error) {
+	res, err := c.GeneratedClient().GetMetricSourceWithResponse(ctx, name)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting metric source: %s", err))
+		return nil, err
+	}
+
+	metricSource, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric source: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source retrieved with Name: %s", metricSource.Data.Name))
+	return &metricSource.Data, nil
+}
+
+func (c *Client) CreateMetricSource(ctx context.Context, metricSource MetricSourceAPIRequest) (*MetricSourceAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateMetricSourceWithResponse(ctx, metricSource)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating metric source: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create metric source response: %s", res.Body))
+	createdMetricSource, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric source: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source created with Name: %s", createdMetricSource.Data.Name))
+
+	return &createdMetricSource.Data, nil
+}
+
+func (c *Client) UpdateMetricSource(ctx context.Context, name string, planMetricSource MetricSourceAPIRequest) (*MetricSourceAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateMetricSourceWithResponse(ctx, name, planMetricSource)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating metric source '%s': %s", name, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update metric source response: %s", res.Body))
+	updatedMetricSource, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric source: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source updated with Name: %s", updatedMetricSource.Data.Name))
+
+	return &updatedMetricSource.Data, nil
+}
+
+func (c *Client) DeleteMetricSource(ctx context.Context, name string) error {
+	_, err := c.GeneratedClient().DeleteMetricSourceWithResponse(ctx, name)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting metric source: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric source deleted with Name: %s", name))
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// The types of custom metric supported by the provider.
+const (
+	MetricTypeEventCount = "event_count"
+	MetricTypeRatio      = "ratio"
+	MetricTypeFunnel     = "funnel"
+	MetricTypeSum        = "sum"
+)
+
+// MetricAPIRequest holds a custom metric. Which of the definition fields are used depends on the type
+// of the metric: event count and sum metrics use the event name, ratio metrics use the numerator and
+// denominator events, and funnel metrics use the funnel events.
+type MetricAPIRequest = consoleapi.Metric
+
+// GetMetrics retrieves every metric of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetMetrics(ctx context.Context, opts ListOptions) ([]MetricAPIRequest, error) {
+	return listAll(ctx, "metrics/list", opts, func(ctx context.Context, page int, limit int) ([]MetricAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListMetricsWithResponse(ctx, &consoleapi.ListMetricsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		metrics, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return metrics.Data, metrics.Pagination, nil
+	})
+}
+
+// GetMetric retrieves a custom metric by its ID from the Statsig API.
+func (c *Client) GetMetric(ctx context.Context, metricID string) (*MetricAPIRequest, error) {
+	res, err := c.GeneratedClient().GetMetricWithResponse(ctx, metricID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting metric: %s", err))
+		return nil, err
+	}
+
+	metric, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric retrieved with Name: %s; and ID: %s", metric.Data.Name, metric.Data.ID))
+	return &metric.Data, nil
+}
+
+func (c *Client) CreateMetric(ctx context.Context, metric MetricAPIRequest) (*MetricAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateMetricWithResponse(ctx, metric)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating metric: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create metric response: %s", res.Body))
+	createdMetric, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric created with ID: %s", createdMetric.Data.ID))
+
+	return &createdMetric.Data, nil
+}
+
+func (c *Client) UpdateMetric(ctx context.Context, metricID string, planMetric MetricAPIRequest) (*MetricAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateMetricWithResponse(ctx, metricID, planMetric)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating metric '%s': %s", metricID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update metric response: %s", res.Body))
+	updatedMetric, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading metric: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric updated with ID: %s", updatedMetric.Data.ID))
+
+	return &updatedMetric.Data, nil
+}
+
+func (c *Client) DeleteMetric(ctx context.Context, metricID string) error {
+	_, err := c.GeneratedClient().DeleteMetricWithResponse(ctx, metricID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting metric: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Metric deleted with ID: %s", metricID))
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package statsig
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig configures how failed requests are retried.
+//
+// Rate limited requests (429) are always retried, as the API rejected them without processing them. Server errors
+// (5xx) and failed connections are only retried for idempotent methods, since a POST or PATCH may have been applied
+// before the error occurred.
+type RetryConfig struct {
+	// MaxRetries is the number of times a failed request is retried. Requests are not retried when zero.
+	MaxRetries int
+	// MinWait is the wait before the first retry. The wait doubles on every retry.
+	MinWait time.Duration
+	// MaxWait caps the wait between two attempts. A request is not retried when the API asks to wait longer
+	// than MaxWait with the Retry-After header.
+	MaxWait time.Duration
+}
+
+// DefaultRetryConfig returns the retry configuration used by new clients.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 3,
+		MinWait:    time.Second,
+		MaxWait:    30 * time.Second,
+	}
+}
+
+// idempotentMethods are the methods that can be sent more than once without changing the result.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// backoff returns how long to wait before retrying the failed attempt of a request, and whether it should be
+// retried at all. The response is nil when the request failed without a response.
+func (r RetryConfig) backoff(method string, attempt int, res *http.Response) (time.Duration, bool) {
+	if attempt >= r.MaxRetries {
+		return 0, false
+	}
+
+	switch {
+	case res == nil:
+		if !idempotentMethods[method] {
+			return 0, false
+		}
+	case res.StatusCode == http.StatusTooManyRequests:
+		if wait, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			return wait, wait <= r.MaxWait
+		}
+	case res.StatusCode >= 500 && res.StatusCode != http.StatusNotImplemented:
+		if !idempotentMethods[method] {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	// Exponential backoff with jitter, so that concurrent requests do not retry at the same time.
+	wait := r.MinWait << attempt
+	if wait <= 0 || wait > r.MaxWait {
+		wait = r.MaxWait
+	}
+	if wait > 1 {
+		wait = wait/2 + rand.N(wait/2)
+	}
+
+	return wait, true
+}
+
+// retryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,125 @@
+package statsig
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryConfigBackoff(t *testing.T) {
+	config := RetryConfig{MaxRetries: 3, MinWait: time.Second, MaxWait: 10 * time.Second}
+	response := func(statusCode int, retryAfter string) *http.Response {
+		res := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+		if retryAfter != "" {
+			res.Header.Set("Retry-After", retryAfter)
+		}
+		return res
+	}
+
+	testCases := map[string]struct {
+		method   string
+		attempt  int
+		response *http.Response
+		retry    bool
+		minWait  time.Duration
+		maxWait  time.Duration
+	}{
+		"rate limited post":          {method: "POST", response: response(429, ""), retry: true, minWait: 500 * time.Millisecond, maxWait: time.Second},
+		"retry after seconds":        {method: "PATCH", response: response(429, "7"), retry: true, minWait: 7 * time.Second, maxWait: 7 * time.Second},
+		"retry after above max wait": {method: "GET", response: response(429, "60"), retry: false},
+		"server error get":           {method: "GET", attempt: 2, response: response(503, ""), retry: true, minWait: 2 * time.Second, maxWait: 4 * time.Second},
+		"server error delete":        {method: "DELETE", attempt: 2, response: response(500, ""), retry: true, minWait: 2 * time.Second, maxWait: 4 * time.Second},
+		"server error post":          {method: "POST", response: response(502, ""), retry: false},
+		"server error patch":         {method: "PATCH", response: response(500, ""), retry: false},
+		"not implemented":            {method: "GET", response: response(501, ""), retry: false},
+		"client error":               {method: "GET", response: response(400, ""), retry: false},
+		"not found":                  {method: "GET", response: response(404, ""), retry: false},
+		"connection error get":       {method: "GET", retry: true, minWait: 500 * time.Millisecond, maxWait: time.Second},
+		"connection error post":      {method: "POST", retry: false},
+		"max retries":                {method: "GET", attempt: 3, response: response(503, ""), retry: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			wait, retry := config.backoff(testCase.method, testCase.attempt, testCase.response)
+			if retry != testCase.retry {
+				t.Fatalf("expected retry %t, got %t", testCase.retry, retry)
+			}
+			if retry && (wait < testCase.minWait || wait > testCase.maxWait) {
+				t.Errorf("expected a wait between %s and %s, got %s", testCase.minWait, testCase.maxWait, wait)
+			}
+		})
+	}
+}
+
+func TestDoRequestRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch {
+		case attempts == 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "rate limited"}`))
+		case attempts == 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`<html>unavailable</html>`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+
+	if _, err := client.GetGate(context.Background(), "test"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	// Server errors are not retried for POST requests, as the request may have been applied.
+	attempts = 1
+	if _, err := client.CreateGate(context.Background(), GateAPIRequest{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 2 {
+		t.Errorf("expected a single attempt, got %d", attempts-1)
+	}
+}
+
+func TestDoRequestContextCancelled(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Minute, MaxWait: time.Minute}
+
+	// The wait between attempts ends as soon as the deadline is exceeded, rather than after the full backoff.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetGate(ctx, "test")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to return when the deadline is exceeded, took %s", elapsed)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
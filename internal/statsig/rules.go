@@ -0,0 +1,15 @@
+package statsig
+
+import "github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+
+// RuleAPIRequest is a targeting rule shared by gates, dynamic configs and segments.
+//
+// The ID is assigned by the API when the rule is created. Sending the ID back on updates keeps the rule's
+// identity (and its exposure history) stable.
+type RuleAPIRequest = consoleapi.Rule
+
+type ConditionAPIRequest = consoleapi.Condition
+
+// TargetValue is the target value of a rule condition, a list of strings whatever the type of the values sent by the
+// API.
+type TargetValue = consoleapi.TargetValue
@@ -0,0 +1,36 @@
+package statsig
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTargetValueUnmarshalJSON(t *testing.T) {
+	testCases := map[string]struct {
+		input    string
+		expected TargetValue
+	}{
+		"null":        {input: `null`, expected: nil},
+		"string":      {input: `"US"`, expected: TargetValue{"US"}},
+		"number":      {input: `1.5`, expected: TargetValue{"1.5"}},
+		"integer":     {input: `100000`, expected: TargetValue{"100000"}},
+		"boolean":     {input: `true`, expected: TargetValue{"true"}},
+		"string list": {input: `["US", "CA"]`, expected: TargetValue{"US", "CA"}},
+		"mixed list":  {input: `["1.2.0", 3]`, expected: TargetValue{"1.2.0", "3"}},
+		"empty list":  {input: `[]`, expected: TargetValue{}},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got TargetValue
+			if err := json.Unmarshal([]byte(testCase.input), &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %#v, got %#v", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package statsig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+// The types of segment supported by the provider.
+const (
+	SegmentTypeRuleBased = "rule_based"
+	SegmentTypeIDList    = "id_list"
+)
+
+// SegmentIDListChunkSize is the maximum number of IDs sent in a single request when adding or removing
+// IDs of an ID list segment. Larger lists are split into several requests.
+const SegmentIDListChunkSize = 1000
+
+type SegmentAPIRequest = consoleapi.Segment
+
+// SegmentConditionsAPIRequest holds the rules of a rule based segment. A user is in the segment when
+// they pass any of the rules.
+type SegmentConditionsAPIRequest = consoleapi.SegmentConditions
+
+// SegmentIDListAPIRequest holds the IDs of an ID list segment.
+type SegmentIDListAPIRequest = consoleapi.SegmentIDList
+
+// GetSegments retrieves every segment of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetSegments(ctx context.Context, opts ListOptions) ([]SegmentAPIRequest, error) {
+	return listAll(ctx, "segments", opts, func(ctx context.Context, page int, limit int) ([]SegmentAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListSegmentsWithResponse(ctx, &consoleapi.ListSegmentsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		segments, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
+
+		return segments.Data, segments.Pagination, nil
+	})
+}
+
+// GetSegment retrieves a segment by its ID from the Statsig API.
+func (c *Client) GetSegment(ctx context.Context, segmentID string) (*SegmentAPIRequest, error) {
+	res, err := c.GeneratedClient().GetSegmentWithResponse(ctx, segmentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting segment: %s", err))
+		return nil, err
+	}
+
+	segment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading segment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment retrieved with Name: %s; and ID: %s", segment.Data.Name, segment.Data.ID))
+	return &segment.Data, nil
+}
+
+func (c *Client) CreateSegment(ctx context.Context, segment SegmentAPIRequest) (*SegmentAPIRequest, error) {
+	res, err := c.GeneratedClient().CreateSegmentWithResponse(ctx, segment)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error creating segment: %s", err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Create segment response: %s", res.Body))
+	createdSegment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading segment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment created with ID: %s", createdSegment.Data.ID))
+
+	return &createdSegment.Data, nil
+}
+
+func (c *Client) UpdateSegment(ctx context.Context, segmentID string, planSegment SegmentAPIRequest) (*SegmentAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateSegmentWithResponse(ctx, segmentID, planSegment)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating segment '%s': %s", segmentID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update segment response: %s", res.Body))
+	updatedSegment, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading segment: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment updated with ID: %s", updatedSegment.Data.ID))
+
+	return &updatedSegment.Data, nil
+}
+
+func (c *Client) DeleteSegment(ctx context.Context, segmentID string) error {
+	_, err := c.GeneratedClient().DeleteSegmentWithResponse(ctx, segmentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting segment: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment deleted with ID: %s", segmentID))
+
+	return nil
+}
+
+// GetSegmentConditions retrieves the rules of a rule based segment.
+func (c *Client) GetSegmentConditions(ctx context.Context, segmentID string) (*SegmentConditionsAPIRequest, error) {
+	res, err := c.GeneratedClient().GetSegmentConditionsWithResponse(ctx, segmentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting segment conditions: %s", err))
+		return nil, err
+	}
+
+	conditions, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading segment conditions: %s", err))
+		return nil, err
+	}
+
+	return &conditions.Data, nil
+}
+
+// UpdateSegmentConditions replaces the rules of a rule based segment.
+func (c *Client) UpdateSegmentConditions(ctx context.Context, segmentID string, conditions SegmentConditionsAPIRequest) error {
+	_, err := c.GeneratedClient().UpdateSegmentConditionsWithResponse(ctx, segmentID, conditions)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating segment conditions '%s': %s", segmentID, err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Segment conditions updated with ID: %s", segmentID))
+
+	return nil
+}
+
+// GetSegmentIDList retrieves the IDs of an ID list segment.
+func (c *Client) GetSegmentIDList(ctx context.Context, segmentID string) (*SegmentIDListAPIRequest, error) {
+	res, err := c.GeneratedClient().GetSegmentIDListWithResponse(ctx, segmentID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error getting segment ID list: %s", err))
+		return nil, err
+	}
+
+	idList, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading segment ID list: %s", err))
+		return nil, err
+	}
+
+	return &idList.Data, nil
+}
+
+// AddSegmentIDs adds the IDs to an ID list segment, sending at most SegmentIDListChunkSize IDs per request.
+func (c *Client) AddSegmentIDs(ctx context.Context, segmentID string, ids []string) error {
+	for _, chunk := range chunkIDs(ids) {
+		_, err := c.GeneratedClient().AddSegmentIDsWithResponse(ctx, segmentID, SegmentIDListAPIRequest{IDs: chunk})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error adding IDs to segment '%s': %s", segmentID, err))
+			return err
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("Added %d IDs to segment with ID: %s", len(chunk), segmentID))
+	}
+
+	return nil
+}
+
+// RemoveSegmentIDs removes the IDs from an ID list segment, sending at most SegmentIDListChunkSize IDs
+// per request.
+func (c *Client) RemoveSegmentIDs(ctx context.Context, segmentID string, ids []string) error {
+	for _, chunk := range chunkIDs(ids) {
+		// The IDs to remove are sent in the body of the DELETE request.
+		_, err := c.GeneratedClient().RemoveSegmentIDsWithResponse(ctx, segmentID, SegmentIDListAPIRequest{IDs: chunk})
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error removing IDs from segment '%s': %s", segmentID, err))
+			return err
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("Removed %d IDs from segment with ID: %s", len(chunk), segmentID))
+	}
+
+	return nil
+}
+
+func chunkIDs(ids []string) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(ids); start += SegmentIDListChunkSize {
+		end := min(start+SegmentIDListChunkSize, len(ids))
+		chunks = append(chunks, ids[start:end])
+	}
+
+	return chunks
+}
@@ -0,0 +1,89 @@
+package statsig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestSegmentIDsChunked(t *testing.T) {
+	type request struct {
+		method string
+		path   string
+		ids    []string
+	}
+	var requests []request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body SegmentIDListAPIRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("expected a JSON body in the %s request, got error: %s", r.Method, err)
+		}
+		requests = append(requests, request{method: r.Method, path: r.URL.Path, ids: body.IDs})
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL
+
+	ids := make([]string, 2*SegmentIDListChunkSize+500)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("user-%d", i)
+	}
+
+	testCases := map[string]struct {
+		send   func(context.Context, string, []string) error
+		ids    []string
+		method string
+		chunks [][]string
+	}{
+		"add": {
+			send:   client.AddSegmentIDs,
+			ids:    ids,
+			method: http.MethodPost,
+			chunks: [][]string{ids[:SegmentIDListChunkSize], ids[SegmentIDListChunkSize : 2*SegmentIDListChunkSize], ids[2*SegmentIDListChunkSize:]},
+		},
+		"remove": {
+			send:   client.RemoveSegmentIDs,
+			ids:    ids[:SegmentIDListChunkSize+1],
+			method: http.MethodDelete,
+			chunks: [][]string{ids[:SegmentIDListChunkSize], ids[SegmentIDListChunkSize : SegmentIDListChunkSize+1]},
+		},
+		"exactly one chunk": {
+			send:   client.AddSegmentIDs,
+			ids:    ids[:SegmentIDListChunkSize],
+			method: http.MethodPost,
+			chunks: [][]string{ids[:SegmentIDListChunkSize]},
+		},
+		"nothing": {
+			send:   client.RemoveSegmentIDs,
+			method: http.MethodDelete,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests = nil
+			if err := testCase.send(context.Background(), "beta_users", testCase.ids); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(requests) != len(testCase.chunks) {
+				t.Fatalf("expected %d requests, got %d", len(testCase.chunks), len(requests))
+			}
+			for i, chunk := range testCase.chunks {
+				if requests[i].method != testCase.method || requests[i].path != "/segments/beta_users/id_list" {
+					t.Errorf("request %d: expected %s /segments/beta_users/id_list, got %s %s", i, testCase.method, requests[i].method, requests[i].path)
+				}
+				if !slices.Equal(requests[i].ids, chunk) {
+					t.Errorf("request %d: expected %d IDs from %s to %s, got %d IDs", i, len(chunk), chunk[0], chunk[len(chunk)-1], len(requests[i].ids))
+				}
+			}
+		})
+	}
+}
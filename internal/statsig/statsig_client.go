@@ -7,165 +7,218 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
-	client "github.com/useless-solutions/statsig-go-client"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultHostURL is the base URL of the Statsig Console API.
+	DefaultHostURL = "https://statsigapi.net/console/v1"
+
+	// DefaultHTTPTimeout is the time limit of a single HTTP request sent to the Statsig Console API.
+	DefaultHTTPTimeout = 10 * time.Second
 )
 
 type Client struct {
-	Ctx      context.Context
 	HostURL  string
 	APIKey   string
 	Metadata statsigMetadata
 	Client   *http.Client
+	// Headers are added to every request, such as the headers required by a proxy. They cannot replace the
+	// API key or the SDK metadata headers.
+	Headers map[string]string
+	Retry   RetryConfig
+	// Limiter is shared by every request of the Client, so that the resources applied in parallel queue
+	// instead of exceeding the rate limits of the API. Requests are not limited when nil.
+	Limiter *rate.Limiter
+
+	generated     *consoleapi.ClientWithResponses
+	generatedOnce sync.Once
 }
 
 // ErrorResponse is the representation of the response body when an error occurs. This is different from
-// the APIResponse struct as it only contains the message and status code of the error, rather than the data.
+// the responses of the consoleapi package as it only contains the message and status code of the error, rather than
+// the data.
 type ErrorResponse struct {
 	Message    string `json:"message"`
 	StatusCode int    `json:"status"`
 }
 
-// NewClient creates a new Statsig client with the provided API key.
-// The Client instance includes an HTTP client with a 10-second timeout to be used for API requests.
-// @Deprecated: Use NewClient instead.
-func NewDeprecatedClient(_ context.Context, apiKey string) (*Client, error) {
+// NewAPIClient creates the Statsig API client used by the provider, with the provided API key and the default
+// configuration. The Client implements StatsigAPI on top of the generated client of the consoleapi package, see
+// GeneratedClient.
+func NewAPIClient(_ context.Context, apiKey string) (*Client, error) {
 	return &Client{
-		HostURL:  "https://statsigapi.net/console/v1",
+		HostURL:  DefaultHostURL,
 		APIKey:   apiKey,
 		Metadata: getStatsigMetadata(),
-		Client:   &http.Client{Timeout: time.Second * 10},
+		Client:   &http.Client{Timeout: DefaultHTTPTimeout},
+		Retry:    DefaultRetryConfig(),
+		Limiter:  NewLimiter(DefaultRequestsPerSecond),
 	}, nil
 }
 
-func NewClient(apiKey string) client.Client {
-	metadata := getStatsigMetadata()
-	return client.Client{
-		Server: "https://statsigapi.net/console/v1",
-		Client: &http.Client{Timeout: time.Second * 10},
-		RequestEditors: []client.RequestEditorFn{
-			func(ctx context.Context, req *http.Request) error {
-				req.Header.Set("STATSIG-API-KEY", apiKey)
-				if req.Method == "POST" || req.Method == "PATCH" {
-					req.Header.Set("Content-Type", "application/json; charset=UTF-8")
-				}
-				req.Header.Set("STATSIG-SDK-TYPE", metadata.SDKType)
-				req.Header.Set("STATSIG-SDK-VERSION", metadata.SDKVersion)
-				return nil
-			},
-		},
-	}
+// NewClient creates a generated client of the Console API with the provided API key and the default configuration.
+func NewClient(apiKey string) *consoleapi.ClientWithResponses {
+	c, _ := NewAPIClient(context.Background(), apiKey)
+	return c.GeneratedClient()
 }
 
-// Get performs a GET request with the provided endpoint and queryParams.
-// There is no request body.
+// GeneratedClient returns the generated client of the Console API that shares the configuration of the Client:
+// the host URL, the HTTP client and its timeout, the extra headers and the API key. It is built on the first call,
+// so the configuration must not change afterwards.
 //
-// Get returns the response body as a byte slice, or an error if the request fails.
-func (c *Client) Get(endpoint string, queryParams map[string]string) ([]byte, error) {
-	return c.doRequest("GET", endpoint, nil, queryParams)
+// Requests sent through the generated client go through doRequest, so they are retried and rate limited like every
+// other request of the Client, and the responses with a non-2xx status code are returned as an *APIError.
+func (c *Client) GeneratedClient() *consoleapi.ClientWithResponses {
+	c.generatedOnce.Do(func() {
+		// The client can only fail to be created when one of its options fails, which neither of these does.
+		c.generated, _ = consoleapi.NewClientWithResponses(c.HostURL,
+			consoleapi.WithHTTPClient(requestDoer{c}),
+			consoleapi.WithRequestEditorFn(c.editRequest),
+		)
+	})
+
+	return c.generated
 }
 
-// Post performs a POST request with the provided endpoint and requestBody.
-// The request body is marshalled into JSON before being sent.
-//
-// Post returns the response body as a byte slice, or an error if the request fails.
-func (c *Client) Post(endpoint string, requestBody interface{}) ([]byte, error) {
-	return c.doRequest("POST", endpoint, requestBody, nil)
+// requestDoer is the HTTP client of the generated client. It sends every request through the doRequest method of
+// the Client.
+type requestDoer struct {
+	c *Client
 }
 
-// Patch performs a PATCH request with the provided endpoint and requestBody.
-// The request body is marshalled into JSON before being sent.
-//
-// Patch returns the response body as a byte slice, or an error if the request fails.
-func (c *Client) Patch(endpoint string, requestBody interface{}) ([]byte, error) {
-	return c.doRequest("PATCH", endpoint, requestBody, nil)
+func (d requestDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.c.doRequest(req)
 }
 
-// Delete performs a DELETE request with the provided endpoint and queryParams.
-// There is no request body.
+// editRequest adds the Statsig-specific headers and metadata to a request, such as the SDK type and version.
 //
-// Delete returns the response body as a byte slice, or an error if the request fails.
-func (c *Client) Delete(endpoint string, queryParams map[string]string) ([]byte, error) {
-	return c.doRequest("DELETE", endpoint, nil, queryParams)
+// Uniquely, the API Key is included in a custom STATSIG-API-KEY header, rather than the standard Authorization header.
+// The extra headers cannot replace it.
+func (c *Client) editRequest(_ context.Context, req *http.Request) error {
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("STATSIG-API-KEY", c.APIKey)
+	if req.Method == "POST" || req.Method == "PATCH" || req.Method == "PUT" || req.Body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+	req.Header.Set("STATSIG-SDK-TYPE", c.Metadata.SDKType)
+	req.Header.Set("STATSIG-SDK-VERSION", c.Metadata.SDKVersion)
+	return nil
 }
 
-// doRequest performs an HTTP request that is built with the provided method, endpoint, body, and queryParams.
-// The request is first build using the buildRequest method, and then executed using the Statsig Client's HTTP client.
-//
-// The API returns an error message in the response body when an error occurs. Unknown (unexpected) errors are parsed
-// and returned as-is, while known errors are returned as a formatted error message.
-func (c *Client) doRequest(method string, endpoint string, requestBody interface{}, queryParams map[string]string) ([]byte, error) {
-	req, err := c.buildRequest(method, endpoint, requestBody, queryParams)
-	if err != nil {
-		return nil, err
-	}
-	res, err := c.Client.Do(req)
-	if err != nil {
-		return nil, err
+// responseBody returns the decoded body of a successful response of the generated client. The generated client only
+// decodes JSON bodies, so an error is returned when the response has none, such as when a proxy answers the request
+// instead of the API.
+func responseBody[T any](res *http.Response, body *T) (*T, error) {
+	if body == nil {
+		return nil, fmt.Errorf("%s %s returned status %d without a JSON body (Content-Type %q)",
+			res.Request.Method, res.Request.URL, res.StatusCode, res.Header.Get("Content-Type"))
 	}
-	defer res.Body.Close()
 
-	switch {
-	case res.StatusCode == 401:
-		return nil, fmt.Errorf("Unauthorized request to %s. Please check your API key.", req.URL)
-	case res.StatusCode < 200 || res.StatusCode >= 300:
-		parsedBody, err := io.ReadAll(res.Body)
-		if err != nil {
+	return body, nil
+}
+
+// doRequest performs an HTTP request. Failed attempts are retried according to the retry configuration of the
+// Client, see RetryConfig. The body of the request is sent again on every attempt.
+//
+// The API returns an error message in the response body when an error occurs. Requests the API responds to with a
+// non-2xx status code are returned as an *APIError, while other errors, such as connection failures, are returned as-is.
+// The body of the response is read in full, so it can be closed whenever.
+//
+// The request, the wait for the rate limiter and the wait between attempts are all cancelled when the context of
+// the request is done.
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		res, err := c.send(attemptReq)
+		if err == nil {
+			return res, nil
+		}
+
+		wait, retry := c.Retry.backoff(req.Method, attempt, res)
+		if !retry {
 			return nil, err
 		}
-		errorResponse := ErrorResponse{}
-		fmt.Sprintln(string(parsedBody))
-		if err := json.Unmarshal(parsedBody, &errorResponse); err != nil {
-			return nil, fmt.Errorf("Failed to perform request to %s with status code %d and response body: %s", req.URL, res.StatusCode, errorResponse.Message)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
+	}
+}
 
-		return nil, fmt.Errorf(errorResponse.Message)
+// send performs a single attempt of the request with the Statsig Client's HTTP client.
+//
+// The response is returned along with the error when the request fails, so the status code and headers can be
+// inspected. The response is nil when no response was received.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer res.Body.Close()
 
 	parsedBody, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return res, err
 	}
+	res.Body = io.NopCloser(bytes.NewReader(parsedBody))
 
-	return parsedBody, err
-}
+	switch {
+	case res.StatusCode == 401:
+		return res, &APIError{
+			StatusCode: res.StatusCode,
+			Message:    "Unauthorized request. Please check your API key.",
+			Method:     req.Method,
+			URL:        req.URL.String(),
+		}
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		apiErr := &APIError{
+			StatusCode: res.StatusCode,
+			Method:     req.Method,
+			URL:        req.URL.String(),
+		}
 
-// buildRequest creates an HTTP request with the provided method, endpoint, body, and query parameters.
-// The request includes Statsig-specific headers and metadata, such as the SDK type and version.
-//
-// Uniquely, the API Key is included in a custom STATSIG-API-KEY header, rather than the standard Authorization header.
-func (c *Client) buildRequest(method, endpoint string, body interface{}, queryParams map[string]string) (*http.Request, error) {
-	var bodyBuf io.Reader
-	if body != nil {
-		bodyBytes, err := json.Marshal(body)
-		if err != nil {
-			return nil, err
+		// Errors that are not returned by the API itself, such as those of a proxy, are not JSON. The raw body is
+		// kept as the message, so the reason for the error is not lost.
+		errorResponse := &ErrorResponse{}
+		if err := json.Unmarshal(parsedBody, errorResponse); err != nil {
+			apiErr.Message = strings.TrimSpace(string(parsedBody))
+		} else {
+			apiErr.Message = errorResponse.Message
 		}
-		bodyBuf = bytes.NewBuffer(bodyBytes)
-	} else {
-		if method == "POST" {
-			bodyBuf = bytes.NewBufferString("{}")
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(res.StatusCode)
 		}
-	}
-	url := fmt.Sprintf("%s/%s", c.HostURL, endpoint)
-	req, err := http.NewRequest(method, url, bodyBuf)
-	if err != nil {
-		return nil, err
-	}
 
-	req.Header.Add("STATSIG-API-KEY", c.APIKey)
-	if method == "POST" || method == "PATCH" {
-		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+		return res, apiErr
 	}
-	req.Header.Add("STATSIG-SDK-TYPE", c.Metadata.SDKType)
-	req.Header.Add("STATSIG-SDK-VERSION", c.Metadata.SDKVersion)
 
-	// Add query parameters if any
-	q := req.URL.Query()
-	for key, value := range queryParams {
-		q.Add(key, value)
-	}
-	req.URL.RawQuery = q.Encode()
-	return req, nil
+	return res, nil
 }
@@ -0,0 +1,132 @@
+package statsig
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
+)
+
+func TestRequestHeaders(t *testing.T) {
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL + "/console/v1"
+	client.Headers = map[string]string{
+		"X-Proxy-Authorization": "proxy-token",
+		"STATSIG-API-KEY":       "console-override",
+	}
+
+	if _, err := client.GetGates(context.Background(), ListOptions{PageSize: 2}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if url := received.URL.String(); url != "/console/v1/gates?limit=2&page=1" {
+		t.Errorf("expected the request to be sent to the configured host, got %s", url)
+	}
+	if value := received.Header.Get("X-Proxy-Authorization"); value != "proxy-token" {
+		t.Errorf("expected the extra header to be set, got %q", value)
+	}
+	if value := received.Header.Get("STATSIG-API-KEY"); value != "console-test" {
+		t.Errorf("expected the extra headers not to replace the API key, got %q", value)
+	}
+	if value := received.Header.Get("Content-Type"); value != "" {
+		t.Errorf("expected no content type without a body, got %q", value)
+	}
+}
+
+func TestGeneratedClient(t *testing.T) {
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = "https://statsig.example.com/console/v1"
+	client.Headers = map[string]string{"X-Team": "growth"}
+
+	generated := client.GeneratedClient()
+	if client.GeneratedClient() != generated {
+		t.Error("expected the generated client to be built once")
+	}
+
+	req, err := consoleapi.NewCreateGateRequest(client.HostURL+"/", GateAPIRequest{Name: "checkout"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if url := req.URL.String(); url != "https://statsig.example.com/console/v1/gates" {
+		t.Errorf("expected the request to be sent to the host URL, got %s", url)
+	}
+	for _, edit := range generated.ClientInterface.(*consoleapi.Client).RequestEditors {
+		if err := edit(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	for key, expected := range map[string]string{
+		"STATSIG-API-KEY": "console-test",
+		"X-Team":          "growth",
+		"Content-Type":    "application/json; charset=UTF-8",
+	} {
+		if value := req.Header.Get(key); value != expected {
+			t.Errorf("expected the %s header to be %q, got %q", key, expected, value)
+		}
+	}
+}
+
+func TestGeneratedClientDoRequest(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch {
+		case r.URL.Path == "/gates/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "gate not found", "status": 404}`))
+		case r.URL.Path == "/gates/proxied":
+			_, _ = w.Write([]byte(`<html>ok</html>`))
+		case len(bodies) == 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": {"id": "checkout", "name": "checkout"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewAPIClient(context.Background(), "console-test")
+	client.HostURL = server.URL
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+
+	// The requests of the generated client are retried, with the same body on every attempt.
+	gate, err := client.UpdateGate(context.Background(), "checkout", GateAPIRequest{Name: "checkout"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gate.ID != "checkout" {
+		t.Errorf("expected the gate of the last attempt, got %+v", gate)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || !strings.Contains(bodies[1], `"name":"checkout"`) {
+		t.Errorf("expected 2 attempts with the same body, got %q", bodies)
+	}
+
+	// Their errors are returned as an *APIError.
+	_, err = client.GetGate(context.Background(), "missing")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !IsNotFound(err) || apiErr.Message != "gate not found" {
+		t.Errorf("expected a not found *APIError, got %v", err)
+	}
+
+	// A successful response without a JSON body is an error, rather than an empty gate.
+	if _, err := client.GetGate(context.Background(), "proxied"); err == nil || !strings.Contains(err.Error(), "without a JSON body") {
+		t.Errorf("expected an error for a response without a JSON body, got %v", err)
+	}
+}
@@ -0,0 +1,532 @@
+// Package statsigfake provides an in-memory implementation of statsig.StatsigAPI, so the plan and apply logic of
+// the resources can be unit tested without sending HTTP requests.
+package statsigfake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// Ensure the fake satisfies the StatsigAPI interface.
+var _ statsig.StatsigAPI = &API{}
+
+// API is an in-memory Statsig Console API. Objects are identified by their ID, which defaults to their name like
+// the IDs derived by the API. Tags and target apps are assigned a generated ID instead, which differs from their
+// name as it does in the API. Missing objects return an *statsig.APIError with a 404 status code, so the
+// not found handling of the resources can be tested as well.
+//
+// The zero value is not usable, use New instead. An API is safe for concurrent use.
+type API struct {
+	mu sync.Mutex
+
+	tags           *store[statsig.TagAPIRequest]
+	targetApps     *store[statsig.TargetAppAPIRequest]
+	gates          *store[statsig.GateAPIRequest]
+	dynamicConfigs *store[statsig.DynamicConfigAPIRequest]
+	experiments    *store[statsig.ExperimentAPIRequest]
+	layers         *store[statsig.LayerAPIRequest]
+	segments       *store[statsig.SegmentAPIRequest]
+	holdouts       *store[statsig.HoldoutAPIRequest]
+	metrics        *store[statsig.MetricAPIRequest]
+	metricSources  *store[statsig.MetricSourceAPIRequest]
+
+	segmentConditions map[string]statsig.SegmentConditionsAPIRequest
+	segmentIDs        map[string][]string
+
+	// nextID numbers the generated IDs.
+	nextID int
+}
+
+// New returns an empty API.
+func New() *API {
+	return &API{
+		tags:              newStore[statsig.TagAPIRequest]("tags"),
+		targetApps:        newStore[statsig.TargetAppAPIRequest]("target_apps"),
+		gates:             newStore[statsig.GateAPIRequest]("gates"),
+		dynamicConfigs:    newStore[statsig.DynamicConfigAPIRequest]("dynamic_configs"),
+		experiments:       newStore[statsig.ExperimentAPIRequest]("experiments"),
+		layers:            newStore[statsig.LayerAPIRequest]("layers"),
+		segments:          newStore[statsig.SegmentAPIRequest]("segments"),
+		holdouts:          newStore[statsig.HoldoutAPIRequest]("holdouts"),
+		metrics:           newStore[statsig.MetricAPIRequest]("metrics"),
+		metricSources:     newStore[statsig.MetricSourceAPIRequest]("metrics/metric_source"),
+		segmentConditions: map[string]statsig.SegmentConditionsAPIRequest{},
+		segmentIDs:        map[string][]string{},
+	}
+}
+
+// idOrName returns the ID of a new object, which defaults to its name.
+func idOrName(id string, name string) string {
+	if id != "" {
+		return id
+	}
+
+	return name
+}
+
+// generatedID returns the ID of a new object, or a generated one when it is empty. The generated IDs are
+// numbered in creation order, so they are deterministic.
+func (a *API) generatedID(id string, prefix string) string {
+	if id != "" {
+		return id
+	}
+
+	a.nextID++
+	return fmt.Sprintf("%s%d", prefix, a.nextID)
+}
+
+func (a *API) GetTags(_ context.Context, opts statsig.ListOptions) ([]statsig.TagAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tags.list(opts), nil
+}
+
+func (a *API) GetTag(_ context.Context, tagName string) (*statsig.TagAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tags.get(tagName)
+}
+
+// CreateTag stores the tag by its name, as tags are referenced by name by the API.
+func (a *API) CreateTag(_ context.Context, tag statsig.TagAPIRequest) (*statsig.TagAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tag.ID = a.generatedID(tag.ID, "tag")
+	return a.tags.create(tag.Name, tag)
+}
+
+func (a *API) UpdateTag(_ context.Context, tagName string, planTag statsig.TagAPIRequest) (*statsig.TagAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	tag, err := a.tags.get(tagName)
+	if err != nil {
+		return nil, err
+	}
+	// The Core status of a tag cannot be changed through the API.
+	tag.Description = planTag.Description
+	return a.tags.update(tagName, *tag)
+}
+
+func (a *API) DeleteTag(_ context.Context, tagName string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tags.delete(tagName)
+}
+
+func (a *API) GetTargetApps(_ context.Context, opts statsig.ListOptions) ([]statsig.TargetAppAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.targetApps.list(opts), nil
+}
+
+func (a *API) GetTargetApp(_ context.Context, targetAppID string) (*statsig.TargetAppAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.targetApps.get(targetAppID)
+}
+
+func (a *API) CreateTargetApp(_ context.Context, targetApp statsig.TargetAppAPIRequest) (*statsig.TargetAppAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	targetApp.ID = a.generatedID(targetApp.ID, "targetApp")
+	return a.targetApps.create(targetApp.ID, targetApp)
+}
+
+func (a *API) UpdateTargetApp(_ context.Context, targetAppID string, planTargetApp statsig.TargetAppAPIRequest) (*statsig.TargetAppAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	targetApp, err := a.targetApps.get(targetAppID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The members that are left out of the request are kept.
+	targetApp.Name = planTargetApp.Name
+	targetApp.Description = planTargetApp.Description
+	if planTargetApp.Gates != nil {
+		targetApp.Gates = planTargetApp.Gates
+	}
+	if planTargetApp.DynamicConfigs != nil {
+		targetApp.DynamicConfigs = planTargetApp.DynamicConfigs
+	}
+	if planTargetApp.Experiments != nil {
+		targetApp.Experiments = planTargetApp.Experiments
+	}
+	return a.targetApps.update(targetAppID, *targetApp)
+}
+
+func (a *API) DeleteTargetApp(_ context.Context, targetAppID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.targetApps.delete(targetAppID)
+}
+
+func (a *API) GetGates(_ context.Context, opts statsig.ListOptions) ([]statsig.GateAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.gates.list(opts), nil
+}
+
+func (a *API) GetGate(_ context.Context, gateID string) (*statsig.GateAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.gates.get(gateID)
+}
+
+func (a *API) CreateGate(_ context.Context, gate statsig.GateAPIRequest) (*statsig.GateAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	gate.ID = idOrName(gate.ID, gate.Name)
+	return a.gates.create(gate.ID, gate)
+}
+
+func (a *API) UpdateGate(_ context.Context, gateID string, planGate statsig.GateAPIRequest) (*statsig.GateAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planGate.ID = gateID
+	return a.gates.update(gateID, planGate)
+}
+
+func (a *API) DeleteGate(_ context.Context, gateID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.gates.delete(gateID)
+}
+
+func (a *API) GetDynamicConfigs(_ context.Context, opts statsig.ListOptions) ([]statsig.DynamicConfigAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.dynamicConfigs.list(opts), nil
+}
+
+func (a *API) GetDynamicConfig(_ context.Context, dynamicConfigID string) (*statsig.DynamicConfigAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.dynamicConfigs.get(dynamicConfigID)
+}
+
+func (a *API) CreateDynamicConfig(_ context.Context, dynamicConfig statsig.DynamicConfigAPIRequest) (*statsig.DynamicConfigAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	dynamicConfig.ID = idOrName(dynamicConfig.ID, dynamicConfig.Name)
+	return a.dynamicConfigs.create(dynamicConfig.ID, dynamicConfig)
+}
+
+func (a *API) UpdateDynamicConfig(_ context.Context, dynamicConfigID string, planDynamicConfig statsig.DynamicConfigAPIRequest) (*statsig.DynamicConfigAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planDynamicConfig.ID = dynamicConfigID
+	return a.dynamicConfigs.update(dynamicConfigID, planDynamicConfig)
+}
+
+func (a *API) DeleteDynamicConfig(_ context.Context, dynamicConfigID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.dynamicConfigs.delete(dynamicConfigID)
+}
+
+func (a *API) GetExperiments(_ context.Context, opts statsig.ListOptions) ([]statsig.ExperimentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.experiments.list(opts), nil
+}
+
+func (a *API) GetExperiment(_ context.Context, experimentID string) (*statsig.ExperimentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.experiments.get(experimentID)
+}
+
+// CreateExperiment stores the experiment in setup, regardless of the requested status.
+func (a *API) CreateExperiment(_ context.Context, experiment statsig.ExperimentAPIRequest) (*statsig.ExperimentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	experiment.ID = idOrName(experiment.ID, experiment.Name)
+	experiment.Status = statsig.ExperimentStatusSetup
+	return a.experiments.create(experiment.ID, experiment)
+}
+
+// UpdateExperiment keeps the status of the experiment, which only changes through the lifecycle operations, and
+// the ID type, layer and groups when the request leaves them out, like the API.
+func (a *API) UpdateExperiment(_ context.Context, experimentID string, planExperiment statsig.ExperimentAPIRequest) (*statsig.ExperimentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	experiment, err := a.experiments.get(experimentID)
+	if err != nil {
+		return nil, err
+	}
+	planExperiment.ID = experimentID
+	planExperiment.Status = experiment.Status
+	if planExperiment.IDType == nil {
+		planExperiment.IDType = experiment.IDType
+	}
+	if planExperiment.LayerID == nil {
+		planExperiment.LayerID = experiment.LayerID
+	}
+	if planExperiment.Groups == nil {
+		planExperiment.Groups = experiment.Groups
+	}
+	return a.experiments.update(experimentID, planExperiment)
+}
+
+func (a *API) DeleteExperiment(_ context.Context, experimentID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.experiments.delete(experimentID)
+}
+
+func (a *API) StartExperiment(_ context.Context, experimentID string) error {
+	return a.transitionExperiment(experimentID, statsig.ExperimentStatusActive, statsig.ExperimentStatusSetup)
+}
+
+func (a *API) MakeExperimentDecision(_ context.Context, experimentID string, _ statsig.ExperimentDecisionAPIRequest) error {
+	return a.transitionExperiment(experimentID, statsig.ExperimentStatusDecisionMade, statsig.ExperimentStatusActive)
+}
+
+func (a *API) AbandonExperiment(_ context.Context, experimentID string) error {
+	return a.transitionExperiment(experimentID, statsig.ExperimentStatusAbandoned, statsig.ExperimentStatusSetup, statsig.ExperimentStatusActive)
+}
+
+// transitionExperiment moves the experiment to the status, rejecting the request like the API when the experiment
+// is not in one of the statuses the transition starts from.
+func (a *API) transitionExperiment(experimentID string, status string, from ...string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	experiment, err := a.experiments.get(experimentID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(from, experiment.Status) {
+		return a.experiments.error(http.MethodPut, experimentID, http.StatusBadRequest, "cannot move from "+experiment.Status+" to "+status)
+	}
+	experiment.Status = status
+	_, err = a.experiments.update(experimentID, *experiment)
+	return err
+}
+
+func (a *API) GetLayers(_ context.Context, opts statsig.ListOptions) ([]statsig.LayerAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.layers.list(opts), nil
+}
+
+func (a *API) GetLayer(_ context.Context, layerID string) (*statsig.LayerAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.layers.get(layerID)
+}
+
+func (a *API) CreateLayer(_ context.Context, layer statsig.LayerAPIRequest) (*statsig.LayerAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	layer.ID = idOrName(layer.ID, layer.Name)
+	return a.layers.create(layer.ID, layer)
+}
+
+func (a *API) UpdateLayer(_ context.Context, layerID string, planLayer statsig.LayerAPIRequest) (*statsig.LayerAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planLayer.ID = layerID
+	return a.layers.update(layerID, planLayer)
+}
+
+// DeleteLayer refuses to delete a layer that still contains experiments, like the API does.
+func (a *API) DeleteLayer(_ context.Context, layerID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if layer, err := a.layers.get(layerID); err == nil && len(layer.Experiments) > 0 {
+		return a.layers.error(http.MethodDelete, layerID, http.StatusBadRequest, "still contains experiments")
+	}
+	return a.layers.delete(layerID)
+}
+
+func (a *API) GetSegments(_ context.Context, opts statsig.ListOptions) ([]statsig.SegmentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.segments.list(opts), nil
+}
+
+func (a *API) GetSegment(_ context.Context, segmentID string) (*statsig.SegmentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.segments.get(segmentID)
+}
+
+func (a *API) CreateSegment(_ context.Context, segment statsig.SegmentAPIRequest) (*statsig.SegmentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	segment.ID = idOrName(segment.ID, segment.Name)
+	return a.segments.create(segment.ID, segment)
+}
+
+func (a *API) UpdateSegment(_ context.Context, segmentID string, planSegment statsig.SegmentAPIRequest) (*statsig.SegmentAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planSegment.ID = segmentID
+	return a.segments.update(segmentID, planSegment)
+}
+
+func (a *API) DeleteSegment(_ context.Context, segmentID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.segmentConditions, segmentID)
+	delete(a.segmentIDs, segmentID)
+	return a.segments.delete(segmentID)
+}
+
+func (a *API) GetSegmentConditions(_ context.Context, segmentID string) (*statsig.SegmentConditionsAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.segments.get(segmentID); err != nil {
+		return nil, err
+	}
+	conditions := clone(a.segmentConditions[segmentID])
+	return &conditions, nil
+}
+
+func (a *API) UpdateSegmentConditions(_ context.Context, segmentID string, conditions statsig.SegmentConditionsAPIRequest) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.segments.get(segmentID); err != nil {
+		return err
+	}
+	a.segmentConditions[segmentID] = clone(conditions)
+	return nil
+}
+
+func (a *API) GetSegmentIDList(_ context.Context, segmentID string) (*statsig.SegmentIDListAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.segments.get(segmentID); err != nil {
+		return nil, err
+	}
+	ids := slices.Clone(a.segmentIDs[segmentID])
+	return &statsig.SegmentIDListAPIRequest{IDs: ids, Count: len(ids)}, nil
+}
+
+func (a *API) AddSegmentIDs(_ context.Context, segmentID string, ids []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.segments.get(segmentID); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !slices.Contains(a.segmentIDs[segmentID], id) {
+			a.segmentIDs[segmentID] = append(a.segmentIDs[segmentID], id)
+		}
+	}
+	return nil
+}
+
+func (a *API) RemoveSegmentIDs(_ context.Context, segmentID string, ids []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.segments.get(segmentID); err != nil {
+		return err
+	}
+	a.segmentIDs[segmentID] = slices.DeleteFunc(a.segmentIDs[segmentID], func(id string) bool {
+		return slices.Contains(ids, id)
+	})
+	return nil
+}
+
+func (a *API) GetHoldouts(_ context.Context, opts statsig.ListOptions) ([]statsig.HoldoutAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.holdouts.list(opts), nil
+}
+
+func (a *API) GetHoldout(_ context.Context, holdoutID string) (*statsig.HoldoutAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.holdouts.get(holdoutID)
+}
+
+func (a *API) CreateHoldout(_ context.Context, holdout statsig.HoldoutAPIRequest) (*statsig.HoldoutAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	holdout.ID = idOrName(holdout.ID, holdout.Name)
+	return a.holdouts.create(holdout.ID, holdout)
+}
+
+func (a *API) UpdateHoldout(_ context.Context, holdoutID string, planHoldout statsig.HoldoutAPIRequest) (*statsig.HoldoutAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planHoldout.ID = holdoutID
+	return a.holdouts.update(holdoutID, planHoldout)
+}
+
+func (a *API) DeleteHoldout(_ context.Context, holdoutID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.holdouts.delete(holdoutID)
+}
+
+func (a *API) GetMetrics(_ context.Context, opts statsig.ListOptions) ([]statsig.MetricAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metrics.list(opts), nil
+}
+
+func (a *API) GetMetric(_ context.Context, metricID string) (*statsig.MetricAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metrics.get(metricID)
+}
+
+func (a *API) CreateMetric(_ context.Context, metric statsig.MetricAPIRequest) (*statsig.MetricAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	metric.ID = idOrName(metric.ID, metric.Name)
+	return a.metrics.create(metric.ID, metric)
+}
+
+func (a *API) UpdateMetric(_ context.Context, metricID string, planMetric statsig.MetricAPIRequest) (*statsig.MetricAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planMetric.ID = metricID
+	return a.metrics.update(metricID, planMetric)
+}
+
+func (a *API) DeleteMetric(_ context.Context, metricID string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metrics.delete(metricID)
+}
+
+func (a *API) GetMetricSources(_ context.Context, opts statsig.ListOptions) ([]statsig.MetricSourceAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metricSources.list(opts), nil
+}
+
+// GetMetricSource retrieves a metric source by its name, which is also its ID.
+func (a *API) GetMetricSource(_ context.Context, name string) (*statsig.MetricSourceAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metricSources.get(name)
+}
+
+func (a *API) CreateMetricSource(_ context.Context, source statsig.MetricSourceAPIRequest) (*statsig.MetricSourceAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metricSources.create(source.Name, source)
+}
+
+func (a *API) UpdateMetricSource(_ context.Context, name string, planSource statsig.MetricSourceAPIRequest) (*statsig.MetricSourceAPIRequest, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	planSource.Name = name
+	return a.metricSources.update(name, planSource)
+}
+
+func (a *API) DeleteMetricSource(_ context.Context, name string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.metricSources.delete(name)
+}
@@ -0,0 +1,65 @@
+package statsigfake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+
+	gate, err := api.CreateGate(ctx, statsig.GateAPIRequest{Name: "checkout"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gate.ID != "checkout" {
+		t.Errorf("expected the ID to default to the name, got %q", gate.ID)
+	}
+
+	if _, err := api.CreateGate(ctx, statsig.GateAPIRequest{Name: "checkout"}); !statsig.IsConflict(err) {
+		t.Errorf("expected a conflict error, got %v", err)
+	}
+
+	// The returned objects are copies, so changing them does not change the stored gate.
+	gate.Description = "changed"
+	stored, _ := api.GetGate(ctx, "checkout")
+	if stored.Description != "" {
+		t.Errorf("expected the stored gate to be unchanged, got description %q", stored.Description)
+	}
+
+	if err := api.DeleteGate(ctx, "checkout"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := api.GetGate(ctx, "checkout"); !statsig.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestAPIExperimentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+
+	if _, err := api.CreateExperiment(ctx, statsig.ExperimentAPIRequest{Name: "pricing", Status: statsig.ExperimentStatusActive}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A decision can only be made once the experiment is started.
+	if err := api.MakeExperimentDecision(ctx, "pricing", statsig.ExperimentDecisionAPIRequest{ID: "test"}); err == nil {
+		t.Error("expected an error when making a decision on an experiment in setup")
+	}
+
+	if err := api.StartExperiment(ctx, "pricing"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := api.MakeExperimentDecision(ctx, "pricing", statsig.ExperimentDecisionAPIRequest{ID: "test"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	experiment, _ := api.GetExperiment(ctx, "pricing")
+	if experiment.Status != statsig.ExperimentStatusDecisionMade {
+		t.Errorf("expected the status %q, got %q", statsig.ExperimentStatusDecisionMade, experiment.Status)
+	}
+}
@@ -0,0 +1,103 @@
+package statsigfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// store holds the objects of a single endpoint, keyed by their ID. Objects are copied on the way in and out,
+// like they would be by the JSON encoding of the API, so callers cannot modify the stored objects.
+type store[T any] struct {
+	endpoint string
+	objects  map[string]T
+}
+
+func newStore[T any](endpoint string) *store[T] {
+	return &store[T]{endpoint: endpoint, objects: map[string]T{}}
+}
+
+func (s *store[T]) get(id string) (*T, error) {
+	object, ok := s.objects[id]
+	if !ok {
+		return nil, s.error(http.MethodGet, id, http.StatusNotFound, "not found")
+	}
+
+	object = clone(object)
+	return &object, nil
+}
+
+func (s *store[T]) create(id string, object T) (*T, error) {
+	if _, ok := s.objects[id]; ok {
+		return nil, s.error(http.MethodPost, id, http.StatusConflict, "already exists")
+	}
+
+	s.objects[id] = clone(object)
+	object = clone(object)
+	return &object, nil
+}
+
+func (s *store[T]) update(id string, object T) (*T, error) {
+	if _, ok := s.objects[id]; !ok {
+		return nil, s.error(http.MethodPatch, id, http.StatusNotFound, "not found")
+	}
+
+	s.objects[id] = clone(object)
+	object = clone(object)
+	return &object, nil
+}
+
+func (s *store[T]) delete(id string) error {
+	if _, ok := s.objects[id]; !ok {
+		return s.error(http.MethodDelete, id, http.StatusNotFound, "not found")
+	}
+
+	delete(s.objects, id)
+	return nil
+}
+
+// list returns the objects sorted by ID, so the order is stable between calls.
+func (s *store[T]) list(opts statsig.ListOptions) []T {
+	ids := make([]string, 0, len(s.objects))
+	for id := range s.objects {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	if opts.MaxItems > 0 && len(ids) > opts.MaxItems {
+		ids = ids[:opts.MaxItems]
+	}
+
+	objects := make([]T, 0, len(ids))
+	for _, id := range ids {
+		objects = append(objects, clone(s.objects[id]))
+	}
+
+	return objects
+}
+
+// error returns the error the client returns for a response of the API with the provided status code.
+func (s *store[T]) error(method string, id string, statusCode int, reason string) *statsig.APIError {
+	return &statsig.APIError{
+		StatusCode: statusCode,
+		Message:    fmt.Sprintf("%s %s %s", s.endpoint, id, reason),
+		Method:     method,
+		URL:        fmt.Sprintf("%s/%s", s.endpoint, id),
+	}
+}
+
+func clone[T any](object T) T {
+	var copied T
+	data, err := json.Marshal(object)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		panic(err)
+	}
+
+	return copied
+}
@@ -2,49 +2,45 @@ package statsig
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
 )
 
-type TagAPIRequest struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	IsCore      bool   `json:"isCore"`
-}
+type TagAPIRequest = consoleapi.Tag
 
-func (c *Client) GetTags(ctx context.Context) ([]TagAPIRequest, error) {
-	params := map[string]string{"page": "1", "limit": "100"}
-	response, err := c.Get("tags", params)
-	if err != nil {
-		return nil, err
-	}
+// GetTags retrieves every tag of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetTags(ctx context.Context, opts ListOptions) ([]TagAPIRequest, error) {
+	return listAll(ctx, "tags", opts, func(ctx context.Context, page int, limit int) ([]TagAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListTagsWithResponse(ctx, &consoleapi.ListTagsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
 
-	// Log the response body
-	tflog.Debug(ctx, fmt.Sprintf("Response Body: %s", map[string]interface{}{"response": string(response)}))
-	tagsResponse := APIListResponse[TagAPIRequest]{}
-	if err := json.Unmarshal(response, &tagsResponse); err != nil {
-		return nil, err
-	}
+		tags, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
 
-	return tagsResponse.Data, nil
+		return tags.Data, tags.Pagination, nil
+	})
 }
 
 // GetTag retrieves a tag by its name from the Statsig API.
 //
 // The API does not use IDs for identifying unique objects, so we must retrieve the tag by its Name.
 func (c *Client) GetTag(ctx context.Context, tagName string) (*TagAPIRequest, error) {
-	response, err := c.Get(fmt.Sprintf("tags/%s", tagName), nil)
+	res, err := c.GeneratedClient().GetTagWithResponse(ctx, tagName)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error getting tag: %s", err))
 		return nil, err
 	}
 
-	tag := APIResponse[TagAPIRequest]{}
-	if err := json.Unmarshal(response, &tag); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error unmarshalling tag: %s", err))
+	tag, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading tag: %s", err))
 		return nil, err
 	}
 
@@ -53,17 +49,16 @@ func (c *Client) GetTag(ctx context.Context, tagName string) (*TagAPIRequest, er
 }
 
 func (c *Client) CreateTag(ctx context.Context, tag TagAPIRequest) (*TagAPIRequest, error) {
-	response, err := c.Post("tags", tag)
+	res, err := c.GeneratedClient().CreateTagWithResponse(ctx, tag)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error creating tag: %s", err))
 		return nil, err
 	}
 
-	// Log the response body
-	tflog.Debug(ctx, fmt.Sprintf("Create tag response: %s", response))
-	createdTag := APIResponse[TagAPIRequest]{}
-	if err := json.Unmarshal(response, &createdTag); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error unmarshalling tag: %s", err))
+	tflog.Debug(ctx, fmt.Sprintf("Create tag response: %s", res.Body))
+	createdTag, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading tag: %s", err))
 		return nil, err
 	}
 
@@ -73,17 +68,16 @@ func (c *Client) CreateTag(ctx context.Context, tag TagAPIRequest) (*TagAPIReque
 }
 
 func (c *Client) UpdateTag(ctx context.Context, tagName string, planTag TagAPIRequest) (*TagAPIRequest, error) {
-	response, err := c.Patch(fmt.Sprintf("tags/%s", tagName), planTag)
+	res, err := c.GeneratedClient().UpdateTagWithResponse(ctx, tagName, planTag)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error updating tag '%s': %s", tagName, err))
 		return nil, err
 	}
 
-	// Log the response body
-	tflog.Debug(ctx, fmt.Sprintf("Update tag response: %s", response))
-	updatedTag := APIResponse[TagAPIRequest]{}
-	if err := json.Unmarshal(response, &updatedTag); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error unmarshalling tag: %s", err))
+	tflog.Debug(ctx, fmt.Sprintf("Update tag response: %s", res.Body))
+	updatedTag, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading tag: %s", err))
 		return nil, err
 	}
 
@@ -93,7 +87,7 @@ func (c *Client) UpdateTag(ctx context.Context, tagName string, planTag TagAPIRe
 }
 
 func (c *Client) DeleteTag(ctx context.Context, tagName string) error {
-	_, err := c.Delete(fmt.Sprintf("tags/%s", tagName), nil)
+	_, err := c.GeneratedClient().DeleteTagWithResponse(ctx, tagName)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error deleting tag: %s", err))
 		return err
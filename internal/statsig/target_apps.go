@@ -2,49 +2,45 @@ package statsig
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig/consoleapi"
 )
 
-type TargetAppAPIRequest struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	Description    string   `json:"description"`
-	Gates          []string `json:"gates"`
-	DynamicConfigs []string `json:"dynamicConfigs"`
-	Experiments    []string `json:"experiments"`
-}
+// TargetAppAPIRequest is a target app of the project. The members are left out of a request when nil, so an update
+// keeps the members that are managed elsewhere, while an empty list removes every member.
+type TargetAppAPIRequest = consoleapi.TargetApp
 
-func (c *Client) GetTargetApps(ctx context.Context) ([]TargetAppAPIRequest, error) {
-	params := map[string]string{"page": "1", "limit": "100"}
-	response, err := c.Get("target_apps", params)
-	if err != nil {
-		return nil, err
-	}
+// GetTargetApps retrieves every target app of the project, following each page of the list endpoint.
+// The number of items returned can be capped with opts.MaxItems.
+func (c *Client) GetTargetApps(ctx context.Context, opts ListOptions) ([]TargetAppAPIRequest, error) {
+	return listAll(ctx, "target_apps", opts, func(ctx context.Context, page int, limit int) ([]TargetAppAPIRequest, APIPagination, error) {
+		res, err := c.GeneratedClient().ListTargetAppsWithResponse(ctx, &consoleapi.ListTargetAppsParams{Page: page, Limit: limit})
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
 
-	// Log the response body
-	tflog.Debug(ctx, fmt.Sprintf("Response Body: %s", map[string]interface{}{"response": string(response)}))
-	targetAppsResponse := APIListResponse[TargetAppAPIRequest]{}
-	if err := json.Unmarshal(response, &targetAppsResponse); err != nil {
-		return nil, err
-	}
+		targetApps, err := responseBody(res.HTTPResponse, res.JSON2XX)
+		if err != nil {
+			return nil, APIPagination{}, err
+		}
 
-	return targetAppsResponse.Data, nil
+		return targetApps.Data, targetApps.Pagination, nil
+	})
 }
 
 func (c *Client) CreateTargetApp(ctx context.Context, targetApp TargetAppAPIRequest) (*TargetAppAPIRequest, error) {
-	response, err := c.Post("target_app", targetApp)
+	res, err := c.GeneratedClient().CreateTargetAppWithResponse(ctx, targetApp)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error creating target app: %s", err))
 		return nil, err
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Response Body: %s", map[string]interface{}{"response": string(response)}))
-	createdTargetApp := APIResponse[TargetAppAPIRequest]{}
-	if err := json.Unmarshal(response, &createdTargetApp); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error unmarshalling target app response: %s", err))
+	tflog.Debug(ctx, fmt.Sprintf("Response Body: %s", map[string]interface{}{"response": string(res.Body)}))
+	createdTargetApp, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading target app response: %s", err))
 		return nil, err
 	}
 
@@ -54,18 +50,50 @@ func (c *Client) CreateTargetApp(ctx context.Context, targetApp TargetAppAPIRequ
 }
 
 func (c *Client) GetTargetApp(ctx context.Context, targetAppID string) (*TargetAppAPIRequest, error) {
-	response, err := c.Get(fmt.Sprintf("target_apps/%s", targetAppID), nil)
+	res, err := c.GeneratedClient().GetTargetAppWithResponse(ctx, targetAppID)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Error getting target app: %s", err))
 		return nil, err
 	}
 
-	targetApp := APIResponse[TargetAppAPIRequest]{}
-	if err := json.Unmarshal(response, &targetApp); err != nil {
-		tflog.Error(ctx, fmt.Sprintf("Error unmarshalling target app: %s", err))
+	targetApp, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading target app: %s", err))
 		return nil, err
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Target App retrieved with Name: %s; and ID: %s", targetApp.Data.Name, targetApp.Data.ID))
 	return &targetApp.Data, nil
 }
+
+// UpdateTargetApp changes the target app with the provided ID. Target apps are referenced by their name.
+func (c *Client) UpdateTargetApp(ctx context.Context, targetAppID string, planTargetApp TargetAppAPIRequest) (*TargetAppAPIRequest, error) {
+	res, err := c.GeneratedClient().UpdateTargetAppWithResponse(ctx, targetAppID, planTargetApp)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error updating target app '%s': %s", targetAppID, err))
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Update target app response: %s", res.Body))
+	updatedTargetApp, err := responseBody(res.HTTPResponse, res.JSON2XX)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error reading target app: %s", err))
+		return nil, err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Target App updated with Name: %s; and ID: %s", updatedTargetApp.Data.Name, updatedTargetApp.Data.ID))
+
+	return &updatedTargetApp.Data, nil
+}
+
+func (c *Client) DeleteTargetApp(ctx context.Context, targetAppID string) error {
+	_, err := c.GeneratedClient().DeleteTargetAppWithResponse(ctx, targetAppID)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Error deleting target app: %s", err))
+		return err
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("Target App deleted with ID: %s", targetAppID))
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package statsigtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Mode selects how the acceptance tests reach the Console API.
+type Mode string
+
+const (
+	// ModeFake sends the requests to a Server. This is the default mode.
+	ModeFake Mode = ""
+	// ModeLive sends the requests to the Console API.
+	ModeLive Mode = "live"
+	// ModeRecord sends the requests to the Console API, and records them to a cassette.
+	ModeRecord Mode = "record"
+	// ModeReplay answers the requests from a cassette, without any network access.
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnv returns the mode set with the STATSIG_TEST_MODE environment variable.
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(os.Getenv("STATSIG_TEST_MODE")); mode {
+	case ModeFake, ModeLive, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid STATSIG_TEST_MODE %q, expected %q, %q or %q", mode, ModeLive, ModeRecord, ModeReplay)
+	}
+}
+
+// sensitiveHeaders are never written to a cassette.
+var sensitiveHeaders = []string{"STATSIG-API-KEY", "Authorization", "Proxy-Authorization"}
+
+// recordedRequestHeaders are the only request headers written to a cassette with their value. The other headers,
+// such as the extra_headers of the provider, may hold the credentials of a proxy, so their value is replaced with
+// redactedValue.
+var recordedRequestHeaders = []string{"Content-Type", "STATSIG-SDK-TYPE", "STATSIG-SDK-VERSION"}
+
+// redactedValue replaces the value of the request headers that are not in recordedRequestHeaders.
+const redactedValue = "REDACTED"
+
+// recordedResponseHeaders are the only response headers written to a cassette, as the others vary between
+// recordings without affecting the client.
+var recordedResponseHeaders = []string{"Content-Type", "Retry-After"}
+
+// Cassette is the recording of the requests sent to the Console API and of their responses.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response of the API to it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as written to a cassette. The URL is relative to the host, so a cassette can be
+// replayed whatever the api_url of the provider.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as written to a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording the requests to a cassette, or replaying them from it, depending on
+// its mode. It is meant to be set as the Transport of the HTTP client of a statsig.Client.
+//
+// When replaying, each request is answered with the first interaction of the cassette with the same method, URL
+// and body that was not replayed yet. Requests without such an interaction fail, rather than reaching the network.
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path. In ModeReplay, the cassette is loaded and must exist.
+// In ModeRecord, requests are sent with http.DefaultTransport, and the cassette is written by Save.
+func NewRecorder(mode Mode, path string) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path, transport: http.DefaultTransport}
+
+	switch mode {
+	case ModeRecord:
+	case ModeReplay:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode %q", mode)
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	recorded := RecordedRequest{
+		Method:  req.Method,
+		URL:     req.URL.RequestURI(),
+		Headers: redactHeaders(req.Header),
+		Body:    string(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded, body)
+}
+
+// redactHeaders returns the request headers as written to a cassette: without the sensitiveHeaders, and with the
+// value of every header but the recordedRequestHeaders redacted.
+func redactHeaders(header http.Header) http.Header {
+	redacted := http.Header{}
+	for key := range header {
+		redacted.Set(key, redactedValue)
+	}
+	for _, key := range recordedRequestHeaders {
+		if values := header.Values(key); len(values) > 0 {
+			redacted[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+	for _, key := range sensitiveHeaders {
+		redacted.Del(key)
+	}
+
+	return redacted
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest, body []byte) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	response := RecordedResponse{StatusCode: res.StatusCode, Headers: http.Header{}, Body: string(resBody)}
+	for _, header := range recordedResponseHeaders {
+		if value := res.Header.Get(header); value != "" {
+			response.Headers.Set(header, value)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: recorded, Response: response})
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in cassette %s", recorded.Method, recorded.URL, r.path)
+}
+
+// matches reports whether two requests have the same method, URL and body. JSON bodies are compared by value, so
+// the order of their keys does not matter.
+func matches(a RecordedRequest, b RecordedRequest) bool {
+	if a.Method != b.Method || a.URL != b.URL {
+		return false
+	}
+
+	var aBody, bBody any
+	if json.Unmarshal([]byte(a.Body), &aBody) != nil || json.Unmarshal([]byte(b.Body), &bBody) != nil {
+		return a.Body == b.Body
+	}
+
+	aJSON, _ := json.Marshal(aBody)
+	bJSON, _ := json.Marshal(bBody)
+	return bytes.Equal(aJSON, bJSON)
+}
+
+// Save writes the recorded interactions to the cassette, creating its directory when needed. It does nothing when
+// replaying.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// IsCassetteNotFound reports whether the error returned by NewRecorder is caused by a missing cassette.
+func IsCassetteNotFound(err error) bool {
+	return errors.Is(err, os.ErrNotExist)
+}
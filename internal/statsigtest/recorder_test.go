@@ -0,0 +1,75 @@
+package statsigtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "gate.json")
+	ctx := context.Background()
+
+	server := NewServer()
+	client := newTestClient(t, server)
+	client.Headers = map[string]string{"X-Proxy-Token": "proxy-secret"}
+
+	recorder, err := NewRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.Client.Transport = recorder
+
+	if _, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "a_gate", Description: "recorded"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetGate(ctx, "a_gate"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(data), ConsoleKey) {
+		t.Error("expected the Console API key not to be written to the cassette")
+	}
+	if strings.Contains(string(data), "proxy-secret") || !strings.Contains(string(data), `"X-Proxy-Token"`) {
+		t.Error("expected the value of the extra header to be redacted from the cassette")
+	}
+
+	// The requests are replayed from the cassette, as the server is closed.
+	recorder, err = NewRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.Client.Transport = recorder
+
+	if _, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "a_gate", Description: "recorded"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	gate, err := client.GetGate(ctx, "a_gate")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gate.Description != "recorded" {
+		t.Errorf("expected the recorded gate, got %+v", gate)
+	}
+
+	// Every interaction is replayed once.
+	if _, err := client.GetGate(ctx, "a_gate"); err == nil {
+		t.Error("expected an error for a request that was not recorded")
+	}
+
+	if _, err := NewRecorder(ModeReplay, filepath.Join(t.TempDir(), "missing.json")); !IsCassetteNotFound(err) {
+		t.Errorf("expected a cassette not found error, got %v", err)
+	}
+}
@@ -0,0 +1,439 @@
+// Package statsigtest provides an in-process fake of the Statsig Console API, so the acceptance tests of the
+// provider can run without network access or a Statsig project.
+package statsigtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+// ConsoleKey is a Console API key accepted by both the fake API and the validation of the provider.
+const ConsoleKey = "console-statsigtest"
+
+// basePath is the path the Console API is served under, like the real API.
+const basePath = "/console/v1"
+
+// Server is a fake Statsig Console API. It keeps the objects of every collection of the API in memory, as JSON
+// objects identified by their ID. Like the IDs derived by the API, the ID of a new object defaults to its name,
+// except for tags and target apps which are assigned a generated ID that differs from their name. Rules sent
+// without an ID are assigned one, as the API does. The conditions and the ID lists of segments are kept apart
+// from the segments, as the API serves them on their own endpoints.
+//
+// Errors are returned with the same body as the API, so they decode into a statsig.ErrorResponse.
+type Server struct {
+	*httptest.Server
+
+	mu                sync.Mutex
+	objects           map[string]map[string]map[string]any
+	segmentConditions map[string][]any
+	segmentIDs        map[string][]string
+	faults            []*Fault
+	// nextID numbers the IDs assigned by the server.
+	nextID int
+}
+
+// Fault makes the server fail or slow down the requests it matches, to exercise the error handling of the client.
+type Fault struct {
+	// Method is the HTTP method of the requests the fault applies to. Every method is matched when empty.
+	Method string
+	// Path is the prefix of the path of the requests the fault applies to, relative to the API root, such as
+	// "gates" or "gates/my_gate". Every path is matched when empty.
+	Path string
+	// StatusCode is returned instead of handling the request. The request is handled when zero.
+	StatusCode int
+	// RetryAfter is sent in the Retry-After header along with StatusCode, when not empty.
+	RetryAfter string
+	// Latency delays the response.
+	Latency time.Duration
+	// Times is the number of requests the fault applies to. It applies to every matching request when zero.
+	Times int
+}
+
+// NewServer starts a fake Console API. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		objects:           map[string]map[string]map[string]any{},
+		segmentConditions: map[string][]any{},
+		segmentIDs:        map[string][]string{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// APIURL returns the base URL of the fake Console API, to be used as the api_url of the provider.
+func (s *Server) APIURL() string {
+	return s.URL + basePath
+}
+
+// InjectFault adds a fault to the server. Faults are applied in the order they were injected, and a request is
+// affected by the first fault that matches it.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// Put stores an object, as if it was created in the console outside of Terraform.
+func (s *Server) Put(collection string, object map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, _ = s.create(collection, clone(object))
+}
+
+// Delete removes an object, as if it was deleted in the console outside of Terraform. Objects are keyed by their
+// ID, except tags and metric sources which are keyed by their name.
+func (s *Server) Delete(collection string, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(collection, key)
+}
+
+// Exists reports whether the collection holds an object with the key. Tags and metric sources are keyed by their
+// name, and every other object by its ID.
+func (s *Server) Exists(collection string, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.objects[collection][key]
+	return ok
+}
+
+// Get returns a copy of an object, or nil when it does not exist. Objects are keyed like in Exists.
+func (s *Server) Get(collection string, key string) map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[collection][key]
+	if !ok {
+		return nil
+	}
+
+	return clone(object)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path, ok := strings.CutPrefix(r.URL.Path, basePath+"/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	if fault := s.fault(r.Method, path); fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			if fault.RetryAfter != "" {
+				w.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			writeError(w, fault.StatusCode, http.StatusText(fault.StatusCode))
+			return
+		}
+	}
+
+	if r.Header.Get("STATSIG-API-KEY") == "" {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	collection := parts[0]
+	switch {
+	// Target apps are created through the singular endpoint.
+	case collection == "target_app":
+		collection = "target_apps"
+	// Metric sources are served under the metrics.
+	case collection == "metrics" && len(parts) > 1 && parts[1] == "metric_source":
+		collection, parts = "metric_sources", parts[1:]
+	}
+	// Metrics and metric sources are listed through their list endpoint.
+	if (collection == "metrics" || collection == "metric_sources") && len(parts) == 2 && parts[1] == "list" && r.Method == http.MethodGet {
+		parts = parts[:1]
+	}
+
+	// The IDs to remove from a segment are sent in the body of a DELETE request.
+	var body map[string]any
+	if r.Method != http.MethodGet {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+			return
+		}
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, collection)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		if body == nil {
+			body = map[string]any{}
+		}
+		object, err := s.create(collection, body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeData(w, http.StatusCreated, object)
+	case len(parts) < 2:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	case s.objects[collection][parts[1]] == nil:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", collection, parts[1]))
+	case len(parts) == 3 && collection == "experiments" && r.Method == http.MethodPut:
+		s.transitionExperiment(w, parts[1], parts[2])
+	case len(parts) == 3 && collection == "segments":
+		s.segmentEndpoint(w, r.Method, parts[1], parts[2], body)
+	case len(parts) > 2:
+		writeError(w, http.StatusNotFound, "Not found")
+	case r.Method == http.MethodGet:
+		writeData(w, http.StatusOK, s.objects[collection][parts[1]])
+	case r.Method == http.MethodPatch:
+		object := s.objects[collection][parts[1]]
+		s.assignRuleIDs(body)
+		for key, value := range body {
+			if key != "id" && key != "status" {
+				object[key] = value
+			}
+		}
+		writeData(w, http.StatusOK, object)
+	case r.Method == http.MethodDelete:
+		s.delete(collection, parts[1])
+		writeJSON(w, http.StatusOK, map[string]any{"message": fmt.Sprintf("%s %s deleted", collection, parts[1])})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// fault returns the first fault matching the request, and counts the request against its Times.
+func (s *Server) fault(method string, path string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, fault := range s.faults {
+		if (fault.Method != "" && fault.Method != method) || !strings.HasPrefix(path, fault.Path) {
+			continue
+		}
+
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = slices.Delete(s.faults, i, i+1)
+			}
+		}
+
+		return fault
+	}
+
+	return nil
+}
+
+// create stores a new object. New experiments always start in setup, whatever the requested status.
+//
+// Tags and metric sources are addressed by their name rather than their ID, like in the API. Metric sources
+// have no ID.
+func (s *Server) create(collection string, object map[string]any) (map[string]any, error) {
+	name, _ := object["name"].(string)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if id, _ := object["id"].(string); id == "" && collection != "metric_sources" {
+		switch collection {
+		case "tags":
+			s.nextID++
+			object["id"] = fmt.Sprintf("tag%d", s.nextID)
+		case "target_apps":
+			s.nextID++
+			object["id"] = fmt.Sprintf("targetApp%d", s.nextID)
+		default:
+			object["id"] = name
+		}
+	}
+	if collection == "experiments" {
+		object["status"] = statsig.ExperimentStatusSetup
+	}
+	s.assignRuleIDs(object)
+
+	key, _ := object["id"].(string)
+	if collection == "tags" || collection == "metric_sources" {
+		key = name
+	}
+
+	if s.objects[collection] == nil {
+		s.objects[collection] = map[string]map[string]any{}
+	}
+	if _, ok := s.objects[collection][key]; ok {
+		return nil, fmt.Errorf("%s %s already exists", collection, key)
+	}
+	s.objects[collection][key] = object
+
+	return object, nil
+}
+
+// delete removes an object, along with the conditions and the ID list of a segment.
+func (s *Server) delete(collection string, key string) {
+	delete(s.objects[collection], key)
+	if collection == "segments" {
+		delete(s.segmentConditions, key)
+		delete(s.segmentIDs, key)
+	}
+}
+
+// assignRuleIDs assigns an ID to the rules of the object that do not have one yet.
+func (s *Server) assignRuleIDs(object map[string]any) {
+	rules, _ := object["rules"].([]any)
+	for _, rule := range rules {
+		if rule, ok := rule.(map[string]any); ok {
+			if id, _ := rule["id"].(string); id == "" {
+				s.nextID++
+				rule["id"] = fmt.Sprintf("rule%d", s.nextID)
+			}
+		}
+	}
+}
+
+// list writes a page of the collection, sorted by ID. The page and limit query parameters select the page, like
+// the list endpoints of the API.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collection string) {
+	ids := make([]string, 0, len(s.objects[collection]))
+	for id := range s.objects[collection] {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit < 1 {
+		limit = 100
+	}
+
+	start := min((page-1)*limit, len(ids))
+	end := min(start+limit, len(ids))
+
+	data := make([]map[string]any, 0, end-start)
+	for _, id := range ids[start:end] {
+		data = append(data, s.objects[collection][id])
+	}
+
+	pagination := statsig.APIPagination{
+		ItemsPerPage: limit,
+		PageNumber:   page,
+		TotalItems:   len(ids),
+	}
+	if end < len(ids) {
+		pagination.NextPage = fmt.Sprintf("%s?page=%d&limit=%d", r.URL.Path, page+1, limit)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"data": data, "pagination": pagination})
+}
+
+// transitionExperiment applies a lifecycle action to an experiment, rejecting the actions the experiment cannot
+// take in its current status.
+func (s *Server) transitionExperiment(w http.ResponseWriter, id string, action string) {
+	transitions := map[string]struct {
+		from []string
+		to   string
+	}{
+		"start":         {from: []string{statsig.ExperimentStatusSetup}, to: statsig.ExperimentStatusActive},
+		"make_decision": {from: []string{statsig.ExperimentStatusActive}, to: statsig.ExperimentStatusDecisionMade},
+		"abandon":       {from: []string{statsig.ExperimentStatusSetup, statsig.ExperimentStatusActive}, to: statsig.ExperimentStatusAbandoned},
+	}
+
+	transition, ok := transitions[action]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not found")
+		return
+	}
+
+	experiment := s.objects["experiments"][id]
+	if status, _ := experiment["status"].(string); !slices.Contains(transition.from, status) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Cannot %s an experiment with status %v", action, experiment["status"]))
+		return
+	}
+
+	experiment["status"] = transition.to
+	writeData(w, http.StatusOK, experiment)
+}
+
+// segmentEndpoint serves the conditions and the ID list of a segment. The conditions are replaced as a whole,
+// while IDs are added and removed from the ID list.
+func (s *Server) segmentEndpoint(w http.ResponseWriter, method string, id string, endpoint string, body map[string]any) {
+	switch {
+	case endpoint == "conditions" && method == http.MethodGet:
+		rules := s.segmentConditions[id]
+		if rules == nil {
+			rules = []any{}
+		}
+		writeData(w, http.StatusOK, map[string]any{"rules": rules})
+	case endpoint == "conditions" && method == http.MethodPost:
+		s.assignRuleIDs(body)
+		rules, _ := body["rules"].([]any)
+		s.segmentConditions[id] = rules
+		writeData(w, http.StatusOK, map[string]any{"rules": rules})
+	case endpoint == "id_list" && method == http.MethodGet:
+		ids := slices.Clone(s.segmentIDs[id])
+		if ids == nil {
+			ids = []string{}
+		}
+		writeData(w, http.StatusOK, map[string]any{"ids": ids, "count": len(ids)})
+	case endpoint == "id_list" && (method == http.MethodPost || method == http.MethodDelete):
+		ids, _ := body["ids"].([]any)
+		for _, value := range ids {
+			value, _ := value.(string)
+			switch {
+			case method == http.MethodDelete:
+				s.segmentIDs[id] = slices.DeleteFunc(s.segmentIDs[id], func(existing string) bool { return existing == value })
+			case !slices.Contains(s.segmentIDs[id], value):
+				s.segmentIDs[id] = append(s.segmentIDs[id], value)
+			}
+		}
+		writeData(w, http.StatusOK, map[string]any{"count": len(s.segmentIDs[id])})
+	default:
+		writeError(w, http.StatusNotFound, "Not found")
+	}
+}
+
+func writeData(w http.ResponseWriter, statusCode int, data map[string]any) {
+	writeJSON(w, statusCode, map[string]any{"data": data})
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, statsig.ErrorResponse{Message: message, StatusCode: statusCode})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func clone(object map[string]any) map[string]any {
+	data, _ := json.Marshal(object)
+
+	var copied map[string]any
+	_ = json.Unmarshal(data, &copied)
+	return copied
+}
@@ -0,0 +1,229 @@
+package statsigtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
+)
+
+func newTestClient(t *testing.T, server *Server) *statsig.Client {
+	client, err := statsig.NewAPIClient(context.Background(), ConsoleKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client.HostURL = server.APIURL()
+	client.Retry = statsig.RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+
+	return client
+}
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	gate, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "a_gate", Description: "created"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gate.ID != "a_gate" {
+		t.Errorf("expected the ID to default to the name, got %q", gate.ID)
+	}
+
+	if _, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "a_gate"}); err == nil {
+		t.Error("expected an error when creating a gate that already exists")
+	}
+
+	gate, err = client.UpdateGate(ctx, "a_gate", statsig.GateAPIRequest{Name: "a_gate", Description: "updated"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gate.Description != "updated" {
+		t.Errorf("expected the description to be updated, got %q", gate.Description)
+	}
+
+	if err := client.DeleteGate(ctx, "a_gate"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, err = client.GetGate(ctx, "a_gate")
+	var apiErr *statsig.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Message == "" {
+		t.Errorf("expected a not found error with a message, got %v", err)
+	}
+
+	for i := range 5 {
+		server.Put("tags", map[string]any{"name": fmt.Sprintf("tag_%d", i)})
+	}
+	tags, err := client.GetTags(ctx, statsig.ListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(tags) != 5 || tags[0].Name != "tag_0" || tags[4].Name != "tag_4" {
+		t.Errorf("expected every page of tags in order, got %v", tags)
+	}
+}
+
+func TestServerExperimentLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.CreateExperiment(ctx, statsig.ExperimentAPIRequest{Name: "an_experiment"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	decision := statsig.ExperimentDecisionAPIRequest{ID: "control"}
+	if err := client.MakeExperimentDecision(ctx, "an_experiment", decision); err == nil {
+		t.Error("expected an error when making a decision on an experiment in setup")
+	}
+	if err := client.StartExperiment(ctx, "an_experiment"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.MakeExperimentDecision(ctx, "an_experiment", decision); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if status := server.Get("experiments", "an_experiment")["status"]; status != statsig.ExperimentStatusDecisionMade {
+		t.Errorf("expected the experiment to be decided, got %v", status)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	// Rate limited requests are retried by the client.
+	server.InjectFault(Fault{Path: "gates", StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Times: 2})
+	if _, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "a_gate"}); err != nil {
+		t.Fatalf("expected the rate limited request to be retried, got %s", err)
+	}
+
+	// Server errors are not retried for POST requests.
+	server.InjectFault(Fault{Method: http.MethodPost, StatusCode: http.StatusInternalServerError, Times: 1})
+	_, err := client.CreateGate(ctx, statsig.GateAPIRequest{Name: "another_gate"})
+	var apiErr *statsig.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected an internal server error, got %v", err)
+	}
+	if server.Exists("gates", "another_gate") {
+		t.Error("expected the failed request not to be applied")
+	}
+
+	// Slow responses are cancelled with the context of the request.
+	server.InjectFault(Fault{Path: "gates/a_gate", Latency: time.Minute})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetGate(ctx, "a_gate"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got %v", err)
+	}
+}
+
+func TestServerCollectionMethodNotAllowed(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	for _, method := range []string{http.MethodDelete, http.MethodPatch, http.MethodPut} {
+		req, _ := http.NewRequest(method, server.APIURL()+"/gates", nil)
+		req.Header.Set("STATSIG-API-KEY", ConsoleKey)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = res.Body.Close()
+
+		if res.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("expected %s /gates to be refused with status 405, got %d", method, res.StatusCode)
+		}
+	}
+}
+
+func TestServerSegments(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := client.CreateSegment(ctx, statsig.SegmentAPIRequest{Name: "beta_users", Type: statsig.SegmentTypeIDList}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.AddSegmentIDs(ctx, "beta_users", []string{"user-1", "user-2", "user-3"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := client.RemoveSegmentIDs(ctx, "beta_users", []string{"user-2"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	idList, err := client.GetSegmentIDList(ctx, "beta_users")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !slices.Equal(idList.IDs, []string{"user-1", "user-3"}) || idList.Count != 2 {
+		t.Errorf("expected the IDs that were added and not removed, got %+v", idList)
+	}
+
+	conditions := statsig.SegmentConditionsAPIRequest{Rules: []statsig.RuleAPIRequest{{Name: "employees", PassPercentage: 100}}}
+	if err := client.UpdateSegmentConditions(ctx, "beta_users", conditions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got, err := client.GetSegmentConditions(ctx, "beta_users")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got.Rules) != 1 || got.Rules[0].Name != "employees" || got.Rules[0].ID == "" {
+		t.Errorf("expected the rule to be stored with an ID, got %+v", got.Rules)
+	}
+
+	// Deleting the segment also deletes its IDs.
+	server.Delete("segments", "beta_users")
+	server.Put("segments", map[string]any{"name": "beta_users", "type": statsig.SegmentTypeIDList})
+	if idList, err := client.GetSegmentIDList(ctx, "beta_users"); err != nil || len(idList.IDs) != 0 {
+		t.Errorf("expected a new segment to have no IDs, got %+v: %v", idList, err)
+	}
+}
+
+func TestServerMetricSources(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(t, server)
+	ctx := context.Background()
+
+	for _, name := range []string{"orders", "sessions", "visits"} {
+		if _, err := client.CreateMetricSource(ctx, statsig.MetricSourceAPIRequest{Name: name, SQL: "SELECT 1"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if _, err := client.CreateMetric(ctx, statsig.MetricAPIRequest{Name: "purchases", Type: "event_count"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	source, err := client.UpdateMetricSource(ctx, "orders", statsig.MetricSourceAPIRequest{Name: "orders", SQL: "SELECT 2"})
+	if err != nil || source.SQL != "SELECT 2" {
+		t.Errorf("expected the metric source to be updated, got %+v: %v", source, err)
+	}
+
+	// The metric sources are kept apart from the metrics, and listed through every page.
+	sources, err := client.GetMetricSources(ctx, statsig.ListOptions{PageSize: 2})
+	if err != nil || len(sources) != 3 {
+		t.Errorf("expected the 3 metric sources, got %+v: %v", sources, err)
+	}
+	metrics, err := client.GetMetrics(ctx, statsig.ListOptions{})
+	if err != nil || len(metrics) != 1 || metrics[0].Name != "purchases" {
+		t.Errorf("expected only the metric, got %+v: %v", metrics, err)
+	}
+
+	if err := client.DeleteMetricSource(ctx, "orders"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if server.Exists("metric_sources", "orders") {
+		t.Error("expected the metric source to be deleted")
+	}
+}
@@ -5,10 +5,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
+	"github.com/useless-solutions/terraform-provider-statsig/internal/export"
 	"github.com/useless-solutions/terraform-provider-statsig/internal/provider"
+	"github.com/useless-solutions/terraform-provider-statsig/internal/statsig"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -33,6 +39,14 @@ var (
 )
 
 func main() {
+	// The export mode writes the configuration of an existing project, rather than serving the provider to Terraform.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -50,3 +64,55 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runExport writes the configuration and import blocks of every object of the Statsig project to the output
+// directory. The Console API key is read from the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY environment variable,
+// so it does not end up in the shell history.
+func runExport(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [options]\n\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "Writes the Terraform configuration and import blocks of every object of a Statsig project.")
+		fmt.Fprintln(flags.Output(), "The Console API key is read from the STATSIG_CONSOLE_KEY or STATSIG_CONSOLE_API_KEY environment variable,")
+		fmt.Fprintln(flags.Output(), "or from the file named by the STATSIG_CONSOLE_API_KEY_FILE environment variable.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+
+	apiURL := statsig.DefaultHostURL
+	if value := os.Getenv("STATSIG_API_URL"); value != "" {
+		apiURL = value
+	}
+
+	out := flags.String("out", ".", "the directory the configuration is written to, which must not contain any of the exported files")
+	flags.StringVar(&apiURL, "api-url", apiURL, "the base URL of the Statsig Console API, which defaults to the STATSIG_API_URL environment variable")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	consoleAPIKey, source, err := provider.ConsoleAPIKeyFromEnv()
+	if err != nil {
+		return fmt.Errorf("unable to read the Statsig Console API key from %s: %w", source, err)
+	}
+	if consoleAPIKey == "" && source != "" {
+		return fmt.Errorf("the Statsig Console API key read from %s is empty", source)
+	}
+	if consoleAPIKey == "" {
+		return errors.New("the STATSIG_CONSOLE_KEY, STATSIG_CONSOLE_API_KEY or STATSIG_CONSOLE_API_KEY_FILE environment variable must be set to a Statsig Console API key")
+	}
+
+	client, err := statsig.NewAPIClient(ctx, consoleAPIKey)
+	if err != nil {
+		return err
+	}
+	client.HostURL = strings.TrimSuffix(apiURL, "/")
+
+	result, err := export.Export(ctx, client, *out)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d resources to %s: %s\n", result.Resources, *out, strings.Join(result.Files, ", "))
+	fmt.Println("Run \"terraform plan\" to review the import, then delete imports.tf once it is applied.")
+	return nil
+}